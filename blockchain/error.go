@@ -97,6 +97,10 @@ var (
 	// making the transaction invalid, rather a DOS protection.
 	ErrOversizedData = errors.New("oversized data")
 
+	// ErrOversizedPayload is returned if the payload of a transaction is greater than
+	// the limit configured for the txpool. Like ErrOversizedData, it is not a consensus error.
+	ErrOversizedPayload = errors.New("oversized payload")
+
 	// ErrInvlidUnitPrice is returned if gas price of transaction is not equal to UnitPrice
 	ErrInvalidUnitPrice = errors.New("invalid unit price")
 
@@ -25,6 +25,8 @@ func (g Genesis) MarshalJSON() ([]byte, error) {
 		ExtraData  hexutil.Bytes                               `json:"extraData"`
 		Governance []byte                                      `json:"governanceData"`
 		BlockScore *math.HexOrDecimal256                       `json:"blockScore"`
+		Coinbase   common.Address                              `json:"rewardbase"`
+		MixHash    common.Hash                                 `json:"randaoMixHash"`
 		Alloc      map[common.UnprefixedAddress]GenesisAccount `json:"alloc"      gencodec:"required"`
 		Number     math.HexOrDecimal64                         `json:"number"`
 		GasUsed    math.HexOrDecimal64                         `json:"gasUsed"`
@@ -36,6 +38,8 @@ func (g Genesis) MarshalJSON() ([]byte, error) {
 	enc.ExtraData = g.ExtraData
 	enc.Governance = g.Governance
 	enc.BlockScore = (*math.HexOrDecimal256)(g.BlockScore)
+	enc.Coinbase = g.Coinbase
+	enc.MixHash = g.MixHash
 	if g.Alloc != nil {
 		enc.Alloc = make(map[common.UnprefixedAddress]GenesisAccount, len(g.Alloc))
 		for k, v := range g.Alloc {
@@ -56,6 +60,8 @@ func (g *Genesis) UnmarshalJSON(input []byte) error {
 		ExtraData  *hexutil.Bytes                              `json:"extraData"`
 		Governance []byte                                      `json:"governanceData"`
 		BlockScore *math.HexOrDecimal256                       `json:"blockScore"`
+		Coinbase   *common.Address                             `json:"rewardbase"`
+		MixHash    *common.Hash                                `json:"randaoMixHash"`
 		Alloc      map[common.UnprefixedAddress]GenesisAccount `json:"alloc"      gencodec:"required"`
 		Number     *math.HexOrDecimal64                        `json:"number"`
 		GasUsed    *math.HexOrDecimal64                        `json:"gasUsed"`
@@ -90,6 +96,12 @@ func (g *Genesis) UnmarshalJSON(input []byte) error {
 	if dec.BlockScore != nil {
 		g.BlockScore = (*big.Int)(dec.BlockScore)
 	}
+	if dec.Coinbase != nil {
+		g.Coinbase = *dec.Coinbase
+	}
+	if dec.MixHash != nil {
+		g.MixHash = *dec.MixHash
+	}
 	if dec.Alloc == nil {
 		return errors.New("missing required field 'alloc' for Genesis")
 	}
@@ -55,6 +55,8 @@ type Genesis struct {
 	ExtraData  []byte              `json:"extraData"`
 	Governance []byte              `json:"governanceData"`
 	BlockScore *big.Int            `json:"blockScore"`
+	Coinbase   common.Address      `json:"rewardbase"`    // not "coinbase", which older genesis files may have without affecting the genesis block
+	MixHash    common.Hash         `json:"randaoMixHash"` // used only if Randao is enabled at the genesis block; not "mixHash" for the same reason as Coinbase
 	Alloc      GenesisAlloc        `json:"alloc"      gencodec:"required"`
 
 	// These fields are used for consensus tests. Please don't use them
@@ -295,6 +297,22 @@ func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 // ToBlock creates the genesis block and writes state of a genesis specification
 // to the given database (or discards it if nil).
 func (g *Genesis) ToBlock(baseStateRoot common.Hash, db database.DBManager) *types.Block {
+	return types.NewBlock(g.toHeader(baseStateRoot, db), nil, nil)
+}
+
+// ToHeader creates the header of the genesis block like ToBlock, but with the given empty root hash
+// as its transaction and receipt roots instead of types.EmptyRootHash. It can be used without
+// initializing the derive-sha implementation of the process, e.g., by tools building a genesis.
+func (g *Genesis) ToHeader(baseStateRoot common.Hash, db database.DBManager, emptyRootHash common.Hash) *types.Header {
+	head := g.toHeader(baseStateRoot, db)
+	head.TxHash = emptyRootHash
+	head.ReceiptHash = emptyRootHash
+	return head
+}
+
+// toHeader creates the header of the genesis block without the transaction and receipt roots,
+// and writes state of a genesis specification to the given database (or discards it if nil).
+func (g *Genesis) toHeader(baseStateRoot common.Hash, db database.DBManager) *types.Header {
 	if db == nil {
 		db = database.NewMemoryDBManager()
 	}
@@ -317,8 +335,10 @@ func (g *Genesis) ToBlock(baseStateRoot common.Hash, db database.DBManager) *typ
 		stateDB.SetNonce(addr, account.Nonce)
 	}
 	root := stateDB.IntermediateRoot(false)
+	// The hardforks are checked at the genesis number, which is nonzero for a synthetic genesis.
+	number := new(big.Int).SetUint64(g.Number)
 	head := &types.Header{
-		Number:     new(big.Int).SetUint64(g.Number),
+		Number:     number,
 		Time:       new(big.Int).SetUint64(g.Timestamp),
 		TimeFoS:    0,
 		ParentHash: g.ParentHash,
@@ -326,27 +346,31 @@ func (g *Genesis) ToBlock(baseStateRoot common.Hash, db database.DBManager) *typ
 		Governance: g.Governance,
 		GasUsed:    g.GasUsed,
 		BlockScore: g.BlockScore,
+		Rewardbase: g.Coinbase,
 		Root:       root,
 	}
 	if g.BlockScore == nil {
 		head.BlockScore = params.GenesisBlockScore
 	}
-	if g.Config != nil && g.Config.IsMagmaForkEnabled(common.Big0) {
+	if g.Config != nil && g.Config.IsMagmaForkEnabled(number) {
 		if g.Config.Governance != nil && g.Config.Governance.KIP71 != nil {
 			head.BaseFee = new(big.Int).SetUint64(g.Config.Governance.KIP71.LowerBoundBaseFee)
 		} else {
 			head.BaseFee = new(big.Int).SetUint64(params.DefaultLowerBoundBaseFee)
 		}
 	}
-	if g.Config != nil && g.Config.IsRandaoForkEnabled(common.Big0) {
+	if g.Config != nil && g.Config.IsRandaoForkEnabled(number) {
 		head.RandomReveal = params.ZeroRandomReveal
 		head.MixHash = params.ZeroMixHash
+		if !common.EmptyHash(g.MixHash) {
+			head.MixHash = g.MixHash.Bytes()
+		}
 	}
 
 	stateDB.Commit(false)
 	stateDB.Database().TrieDB().Commit(root, true, g.Number)
 
-	return types.NewBlock(head, nil, nil)
+	return head
 }
 
 // Commit writes the block and state of a genesis specification to the database.
@@ -21,6 +21,7 @@
 package blockchain
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/derivesha"
 	"github.com/klaytn/klaytn/blockchain/vm"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/consensus/gxhash"
@@ -48,6 +50,65 @@ func TestDefaultGenesisBlock(t *testing.T) {
 	}
 }
 
+// TestGenesisToHeader tests that ToHeader builds the same header as ToBlock with the given empty root hash.
+func TestGenesisToHeader(t *testing.T) {
+	genesis := genCypressGenesisBlock()
+	header := genesis.ToHeader(common.Hash{}, nil, derivesha.EmptyRootHashOf(genesis.Config.DeriveShaImpl))
+	assert.Equal(t, params.CypressGenesisHash, header.Hash())
+
+	genesis = genCustomGenesisBlock(1000)
+	assert.Equal(t, genesis.ToBlock(common.Hash{}, nil).Hash(), genesis.ToHeader(common.Hash{}, nil, types.EmptyRootHash(common.Big0)).Hash())
+}
+
+// TestGenesisRewardbase tests that the reward base is read only from the "rewardbase" key,
+// so that the genesis files having the "coinbase" key keep their genesis hash.
+func TestGenesisRewardbase(t *testing.T) {
+	InitDeriveSha(params.TestChainConfig)
+	hashOf := func(input string) (common.Hash, common.Address) {
+		genesis := new(Genesis)
+		assert.NoError(t, json.Unmarshal([]byte(input), genesis))
+		header := genesis.ToBlock(common.Hash{}, nil).Header()
+		return header.Hash(), header.Rewardbase
+	}
+
+	base, rewardbase := hashOf(`{"alloc": {}}`)
+	assert.Equal(t, common.Address{}, rewardbase)
+
+	hash, rewardbase := hashOf(`{"coinbase": "0x0000000000000000000000000000000000000bee", "alloc": {}}`)
+	assert.Equal(t, base, hash)
+	assert.Equal(t, common.Address{}, rewardbase)
+
+	hash, rewardbase = hashOf(`{"rewardbase": "0x0000000000000000000000000000000000000bee", "alloc": {}}`)
+	assert.NotEqual(t, base, hash)
+	assert.Equal(t, common.HexToAddress("0xbee"), rewardbase)
+}
+
+// TestGenesisRandaoMixHash tests that the genesis hash with Randao enabled at the genesis block is
+// kept unless the mix hash is given by the "randaoMixHash" key, which the genesis files having
+// the "mixHash" key for Ethereum compatibility do not have.
+func TestGenesisRandaoMixHash(t *testing.T) {
+	InitDeriveSha(&params.ChainConfig{})
+	headerOf := func(input string) *types.Header {
+		genesis := new(Genesis)
+		assert.NoError(t, json.Unmarshal([]byte(input), genesis))
+		assert.True(t, genesis.Config.IsRandaoForkEnabled(common.Big0))
+		return genesis.ToBlock(common.Hash{}, nil).Header()
+	}
+
+	// the genesis without a mix hash has params.ZeroMixHash in its header
+	expected := common.HexToHash("0x1712021f46fcf04f713bb86be35fbd1080ed9db6b1c273756c22372f10ec980a")
+	header := headerOf(`{"config": {"chainId": 1, "randaoCompatibleBlock": 0}, "timestamp": "0x6553f100", "alloc": {}}`)
+	assert.Equal(t, expected, header.Hash())
+	assert.Equal(t, params.ZeroMixHash, header.MixHash)
+
+	header = headerOf(`{"config": {"chainId": 1, "randaoCompatibleBlock": 0}, "timestamp": "0x6553f100", "mixHash": "0x0000000000000000000000000000000000000000000000000000000000000bee", "alloc": {}}`)
+	assert.Equal(t, expected, header.Hash())
+
+	header = headerOf(`{"config": {"chainId": 1, "randaoCompatibleBlock": 0}, "timestamp": "0x6553f100", "randaoMixHash": "0x0000000000000000000000000000000000000000000000000000000000000bee", "alloc": {}}`)
+	assert.NotEqual(t, expected, header.Hash())
+	assert.Equal(t, common.HexToHash("0xbee").Bytes(), header.MixHash)
+}
+
 // TestHardCodedChainConfigUpdate tests the public network's chainConfig update.
 func TestHardCodedChainConfigUpdate(t *testing.T) {
 	cypressGenesisBlock, baobabGenesisBlock := genCypressGenesisBlock(), genBaobabGenesisBlock()
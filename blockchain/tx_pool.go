@@ -134,6 +134,8 @@ type TxPoolConfig struct {
 
 	NoAccountCreation            bool // Whether account creation transactions should be disabled
 	EnableSpamThrottlerAtRuntime bool // Enable txpool spam throttler at runtime
+
+	MaxPayloadSize uint64 // Maximum payload size of a transaction, 0 for no limit other than MaxTxDataSize
 }
 
 // DefaultTxPoolConfig contains the default configurations for the transaction
@@ -738,6 +740,9 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 	if uint64(tx.Size()) > MaxTxDataSize {
 		return ErrOversizedData
 	}
+	if pool.config.MaxPayloadSize > 0 && uint64(len(tx.Data())) > pool.config.MaxPayloadSize {
+		return ErrOversizedPayload
+	}
 
 	// Transactions can't be negative. This may never happen using RLP decoded
 	// transactions but may occur if you create a transaction using the RPC.
@@ -581,6 +581,44 @@ func TestTransactionNegativeValue(t *testing.T) {
 	}
 }
 
+func TestTransactionOversizedPayload(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+	blockchain := &testBlockChain{statedb, 10000000, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.MaxPayloadSize = 1024
+
+	pool := NewTxPool(config, eip1559Config, blockchain)
+	defer pool.Stop()
+
+	key, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(100000000000))
+
+	payloadTx := func(nonce uint64, size int) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTx(&types.TxInternalDataEthereumDynamicFee{
+			ChainID:      params.TestChainConfig.ChainID,
+			AccountNonce: nonce,
+			GasTipCap:    big.NewInt(1),
+			GasFeeCap:    big.NewInt(1),
+			GasLimit:     200000,
+			Recipient:    &common.Address{},
+			Amount:       big.NewInt(0),
+			Payload:      make([]byte, size),
+			AccessList:   types.AccessList{},
+		}), types.LatestSignerForChainID(params.TestChainConfig.ChainID), key)
+		return tx
+	}
+
+	if err := pool.AddRemote(payloadTx(0, 1024)); err != nil {
+		t.Error("expected", nil, "got", err)
+	}
+	if err := pool.AddRemote(payloadTx(1, 1025)); err != ErrOversizedPayload {
+		t.Error("expected", ErrOversizedPayload, "got", err)
+	}
+}
+
 func TestTransactionChainFork(t *testing.T) {
 	t.Parallel()
 
@@ -75,6 +75,15 @@ func EmptyRootHashMux(num *big.Int) common.Hash {
 	return emptyRoots[getType(num)]
 }
 
+// EmptyRootHashOf returns the empty root hash of the given implementation without InitDeriveSha.
+// An unrecognized implementation falls back to the default one as DeriveShaMux does.
+func EmptyRootHashOf(implType int) common.Hash {
+	if root, ok := emptyRoots[implType]; ok {
+		return root
+	}
+	return emptyRoots[int(params.DefaultDeriveShaImpl)]
+}
+
 func getType(num *big.Int) int {
 	implType := config.DeriveShaImpl
 
@@ -113,6 +113,12 @@ func NewTransactionWithMap(t TxType, values map[TxValueKeyType]interface{}) (tx
 	return tx, retErr
 }
 
+// NewTransactionDynamicFee generates a dynamic fee transaction from tx field values.
+// It is a shorthand for NewTransactionWithMap with TxTypeEthereumDynamicFee.
+func NewTransactionDynamicFee(values map[TxValueKeyType]interface{}) (*Transaction, error) {
+	return NewTransactionWithMap(TxTypeEthereumDynamicFee, values)
+}
+
 // NewTx creates a new transaction.
 func NewTx(data TxInternalData) *Transaction {
 	tx := new(Transaction)
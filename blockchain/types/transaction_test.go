@@ -375,6 +375,71 @@ func TestEffectiveGasPrice(t *testing.T) {
 	assert.Equal(t, want, have)
 }
 
+func TestNewTransactionDynamicFee(t *testing.T) {
+	to := common.HexToAddress("0x1")
+	accessList := AccessList{{Address: to, StorageKeys: []common.Hash{{0x1}}}}
+
+	tx, err := NewTransactionDynamicFee(map[TxValueKeyType]interface{}{
+		TxValueKeyNonce:      uint64(3),
+		TxValueKeyTo:         &to,
+		TxValueKeyAmount:     big.NewInt(10),
+		TxValueKeyGasLimit:   uint64(25000),
+		TxValueKeyGasFeeCap:  big.NewInt(30),
+		TxValueKeyGasTipCap:  big.NewInt(2),
+		TxValueKeyData:       []byte{0x1, 0x2},
+		TxValueKeyAccessList: accessList,
+		TxValueKeyChainID:    big.NewInt(1),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, TxTypeEthereumDynamicFee, tx.Type())
+	assert.Equal(t, uint64(3), tx.Nonce())
+	assert.Equal(t, &to, tx.To())
+	assert.Equal(t, big.NewInt(10), tx.Value())
+	assert.Equal(t, uint64(25000), tx.Gas())
+	assert.Equal(t, big.NewInt(30), tx.GasFeeCap())
+	assert.Equal(t, big.NewInt(2), tx.GasTipCap())
+	assert.Equal(t, []byte{0x1, 0x2}, tx.Data())
+	assert.Equal(t, accessList, tx.AccessList())
+	assert.Equal(t, big.NewInt(1), tx.ChainId())
+
+	// the fields round-trip through the RLP encoding once signed
+	key, _ := crypto.GenerateKey()
+	assert.NoError(t, tx.SignWithKeys(LatestSignerForChainID(big.NewInt(1)), []*ecdsa.PrivateKey{key}))
+	enc, err := rlp.EncodeToBytes(tx)
+	assert.NoError(t, err)
+	dec := new(Transaction)
+	assert.NoError(t, rlp.DecodeBytes(enc, dec))
+	assert.True(t, tx.Equal(dec))
+
+	// a missing field is an error
+	_, err = NewTransactionDynamicFee(map[TxValueKeyType]interface{}{TxValueKeyNonce: uint64(3)})
+	assert.Error(t, err)
+}
+
+func TestTypedTransactionChainId(t *testing.T) {
+	chainID := big.NewInt(8217)
+	for _, v := range []int64{0, 1} {
+		for _, data := range []TxInternalData{
+			newTxInternalDataEthereumAccessListWithValues(0, &testAddr, big.NewInt(0), 21000, big.NewInt(1), nil, AccessList{}, chainID),
+			newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, big.NewInt(0), 21000, big.NewInt(1), big.NewInt(1), nil, AccessList{}, chainID),
+		} {
+			data.SetSignature(TxSignatures{&TxSignature{V: big.NewInt(v), R: big.NewInt(1), S: big.NewInt(1)}})
+			tx := NewTx(data)
+			// the explicit chain ID wins over the one derived from V
+			assert.Equal(t, chainID, tx.ChainId(), "type=%v v=%d", tx.Type(), v)
+			assert.NotEqual(t, deriveChainId(big.NewInt(v)), tx.ChainId(), "type=%v v=%d", tx.Type(), v)
+		}
+	}
+
+	// an unset chain ID is nil
+	for _, data := range []TxInternalData{
+		&TxInternalDataEthereumAccessList{V: big.NewInt(1), R: big.NewInt(1), S: big.NewInt(1)},
+		&TxInternalDataEthereumDynamicFee{V: big.NewInt(1), R: big.NewInt(1), S: big.NewInt(1)},
+	} {
+		assert.Nil(t, NewTx(data).ChainId(), "type=%v", data.Type())
+	}
+}
+
 func TestEffectiveGasTip(t *testing.T) {
 	legacyTx := NewTx(&TxInternalDataLegacy{Price: big.NewInt(1000)})
 	dynamicTx := NewTx(&TxInternalDataEthereumDynamicFee{GasFeeCap: big.NewInt(4000), GasTipCap: big.NewInt(1000)})
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 
@@ -95,6 +96,7 @@ var (
 	errNotTxTypeValueTransferWithFeeDelegator = errors.New("not a fee-delegated value transfer transaction")
 	errNotTxTypeAccountCreation               = errors.New("not account creation transaction type")
 	errUndefinedTxType                        = errors.New("undefined tx type")
+	errEmptyTypedTx                           = errors.New("typed transaction too short")
 	errCannotBeSignedByFeeDelegator           = errors.New("this transaction type cannot be signed by a fee delegator")
 	errUndefinedKeyRemains                    = errors.New("undefined key remains")
 
@@ -105,6 +107,7 @@ var (
 	errValueKeyToMustAddress             = errors.New("To must be a type of common.Address")
 	errValueKeyToMustAddressPointer      = errors.New("To must be a type of *common.Address")
 	errValueKeyAmountMustBigInt          = errors.New("Amount must be a type of *big.Int")
+	errValueKeyAmountMustNonNegative     = errors.New("Amount must be a non-negative value")
 	errValueKeyGasLimitMustUint64        = errors.New("GasLimit must be a type of uint64")
 	errValueKeyGasPriceMustBigInt        = errors.New("GasPrice must be a type of *big.Int")
 	errValueKeyFromMustAddress           = errors.New("From must be a type of common.Address")
@@ -412,6 +415,16 @@ type VM interface {
 	Call(caller ContractRef, addr common.Address, input []byte, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error)
 }
 
+// AccessListTracingVM is a VM which records the accounts and storage slots touched during execution,
+// such as a vm.EVM running with a vm.AccessListTracer.
+type AccessListTracingVM interface {
+	VM
+	// TracedAccessList returns the access list collected so far. It returns false if no access list tracer is set.
+	TracedAccessList() (AccessList, bool)
+	// Rules returns the chain rules the VM is running with.
+	Rules() params.Rules
+}
+
 // Since we cannot access the package `blockchain/state` directly, an interface `StateDB` is introduced.
 // TODO-Klaytn-Refactoring: Transaction and related data structures should be a new package.
 type StateDB interface {
@@ -427,6 +440,13 @@ type StateDB interface {
 	GetKey(addr common.Address) accountkey.AccountKey
 }
 
+// SnapshotStateDB is a StateDB which can revert its changes to a snapshot.
+type SnapshotStateDB interface {
+	StateDB
+	Snapshot() int
+	RevertToSnapshot(int)
+}
+
 func NewTxInternalData(t TxType) (TxInternalData, error) {
 	switch t {
 	case TxTypeLegacyTransaction:
@@ -484,6 +504,45 @@ func NewTxInternalData(t TxType) (TxInternalData, error) {
 	return nil, errUndefinedTxType
 }
 
+// DecodeTypedTx decodes a raw transaction into the internal data of the type given by its leading byte.
+// An RLP list is decoded as a legacy transaction, and the Ethereum typed transactions defined
+// by EIP-2718 are accepted with or without the Klaytn envelope type byte 0x78.
+// The other leading bytes are regarded as Klaytn transaction types.
+func DecodeTypedTx(raw []byte) (TxInternalData, error) {
+	if len(raw) == 0 {
+		return nil, errEmptyTypedTx
+	}
+
+	var (
+		txType TxType
+		body   []byte
+	)
+	switch b := raw[0]; {
+	case b >= 0xc0:
+		txType, body = TxTypeLegacyTransaction, raw
+	case b == byte(EthereumTxTypeEnvelope):
+		if len(raw) < 2 {
+			return nil, errEmptyTypedTx
+		}
+		txType, body = EthereumTxTypeEnvelope<<8|TxType(raw[1]), raw[2:]
+	case b != 0 && EthereumTxTypeEnvelope<<8|TxType(b) < TxTypeEthereumLast:
+		txType, body = EthereumTxTypeEnvelope<<8|TxType(b), raw[1:]
+	case b != 0:
+		txType, body = TxType(b), raw[1:]
+	default:
+		return nil, fmt.Errorf("%w: type byte 0x%02x", errUndefinedTxType, b)
+	}
+
+	txdata, err := NewTxInternalData(txType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, txType)
+	}
+	if err := rlp.DecodeBytes(body, txdata); err != nil {
+		return nil, err
+	}
+	return txdata, nil
+}
+
 func NewTxInternalDataWithMap(t TxType, values map[TxValueKeyType]interface{}) (TxInternalData, error) {
 	switch t {
 	case TxTypeLegacyTransaction:
@@ -572,23 +631,29 @@ func IntrinsicGasPayload(gas uint64, data []byte, isContractCreation bool, rules
 	return gas, nil
 }
 
+// countPayloadBytes returns the number of zero and non-zero bytes in the data.
+func countPayloadBytes(data []byte) (zero, nonzero int) {
+	for _, byt := range data {
+		if byt != 0 {
+			nonzero++
+		}
+	}
+	return len(data) - nonzero, nonzero
+}
+
 func IntrinsicGasPayloadLegacy(gas uint64, data []byte) (uint64, error) {
 	length := uint64(len(data))
 	if length > 0 {
 		// Zero and non-zero bytes are priced differently
-		var nz uint64
-		for _, byt := range data {
-			if byt != 0 {
-				nz++
-			}
-		}
+		zero, nonzero := countPayloadBytes(data)
+		nz := uint64(nonzero)
 		// Make sure we don't exceed uint64 for all data combinations
 		if (math.MaxUint64-gas)/params.TxDataNonZeroGas < nz {
 			return 0, ErrGasUintOverflow
 		}
 		gas += nz * params.TxDataNonZeroGas
 
-		z := uint64(len(data)) - nz
+		z := uint64(zero)
 		if (math.MaxUint64-gas)/params.TxDataZeroGas < z {
 			return 0, ErrGasUintOverflow
 		}
@@ -631,6 +696,67 @@ func IntrinsicGas(data []byte, accessList AccessList, contractCreation bool, r p
 	return gasPayloadWithGas, nil
 }
 
+// IntrinsicGasBreakdown holds the components of the intrinsic gas of a transaction.
+type IntrinsicGasBreakdown struct {
+	Base       uint64 // base cost of a transaction (TxGas)
+	Data       uint64 // cost of the payload bytes
+	AccessList uint64 // cost of the addresses and storage keys in the access list
+	Creation   uint64 // additional cost of contract creation including the init code word cost
+}
+
+// Total returns the sum of all components of the breakdown.
+func (b IntrinsicGasBreakdown) Total() (uint64, error) {
+	gas := b.Base
+	for _, g := range []uint64{b.Data, b.AccessList, b.Creation} {
+		if math.MaxUint64-gas < g {
+			return 0, ErrGasUintOverflow
+		}
+		gas += g
+	}
+	return gas, nil
+}
+
+// IntrinsicGasDetailed computes the 'intrinsic gas' for a message with the given data
+// and returns it split into its components.
+func IntrinsicGasDetailed(data []byte, accessList AccessList, contractCreation bool, r params.Rules) (IntrinsicGasBreakdown, error) {
+	var (
+		breakdown           IntrinsicGasBreakdown
+		dataGas, createdGas uint64
+		err                 error
+	)
+	breakdown.Base = params.TxGas
+
+	if r.IsIstanbul {
+		if dataGas, err = IntrinsicGasPayload(0, data, false, r); err != nil {
+			return IntrinsicGasBreakdown{}, err
+		}
+		// The init code word cost is charged only for contract creation.
+		if createdGas, err = IntrinsicGasPayload(0, data, contractCreation, r); err != nil {
+			return IntrinsicGasBreakdown{}, err
+		}
+	} else {
+		if dataGas, err = IntrinsicGasPayloadLegacy(0, data); err != nil {
+			return IntrinsicGasBreakdown{}, err
+		}
+		createdGas = dataGas
+	}
+	breakdown.Data = dataGas
+
+	if contractCreation {
+		breakdown.Creation = params.TxGasContractCreation - params.TxGas + (createdGas - dataGas)
+	}
+
+	// We charge additional gas for the accessList:
+	// ACCESS_LIST_ADDRESS_COST : gas per address in AccessList
+	// ACCESS_LIST_STORAGE_KEY_COST : gas per storage key in AccessList
+	if accessList != nil {
+		breakdown.AccessList = uint64(len(accessList))*params.TxAccessListAddressGas +
+			uint64(accessList.StorageKeys())*params.TxAccessListStorageKeyGas
+	}
+
+	return breakdown, nil
+}
+
 // CalcFeeWithRatio returns feePayer's fee and sender's fee based on feeRatio.
 // For example, if fee = 100 and feeRatio = 30, feePayer = 30 and feeSender = 70.
 func CalcFeeWithRatio(feeRatio FeeRatio, fee *big.Int) (*big.Int, *big.Int) {
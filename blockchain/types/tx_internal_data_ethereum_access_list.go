@@ -53,6 +53,81 @@ func (al AccessList) StorageKeys() int {
 	return sum
 }
 
+// MergeAccessList returns the union of the access list and the other one, e.g., a user-provided access list
+// and the one generated by tracing. The addresses and the storage keys of each address are deduplicated
+// and kept in the order they first appear. The returned access list does not share memory with the inputs.
+func (al AccessList) MergeAccessList(other AccessList) AccessList {
+	merged := make(AccessList, 0, len(al)+len(other))
+	index := make(map[common.Address]int, len(al)+len(other))
+	keys := make(map[common.Address]map[common.Hash]struct{}, len(al)+len(other))
+
+	for _, list := range []AccessList{al, other} {
+		for _, tuple := range list {
+			i, ok := index[tuple.Address]
+			if !ok {
+				i = len(merged)
+				index[tuple.Address] = i
+				keys[tuple.Address] = make(map[common.Hash]struct{}, len(tuple.StorageKeys))
+				merged = append(merged, AccessTuple{Address: tuple.Address, StorageKeys: []common.Hash{}})
+			}
+			seen := keys[tuple.Address]
+			for _, key := range tuple.StorageKeys {
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				merged[i].StorageKeys = append(merged[i].StorageKeys, key)
+			}
+		}
+	}
+	return merged
+}
+
+// ethAccessTupleJSON is the access tuple format returned by the Ethereum RPC.
+// Unlike AccessTuple, storageKeys can be omitted and the keys can be shorter than 32 bytes.
+type ethAccessTupleJSON struct {
+	Address     *common.Address `json:"address"`
+	StorageKeys []hexutil.Bytes `json:"storageKeys"`
+}
+
+// ParseEthAccessList parses an access list in the format of the Ethereum access-list RPC.
+// Missing or null storage keys are treated as empty, and storage keys shorter than
+// 32 bytes are left-padded with zeros.
+func ParseEthAccessList(raw json.RawMessage) (AccessList, error) {
+	return parseEthAccessList(raw, false)
+}
+
+// parseEthAccessList parses an access list in the format of the Ethereum access-list RPC.
+// If strict is set, storage keys shorter than 32 bytes are rejected instead of being padded.
+func parseEthAccessList(raw json.RawMessage, strict bool) (AccessList, error) {
+	var tuples []ethAccessTupleJSON
+	if err := json.Unmarshal(raw, &tuples); err != nil {
+		return nil, err
+	}
+	if tuples == nil {
+		return nil, nil
+	}
+
+	accessList := make(AccessList, 0, len(tuples))
+	for i, tuple := range tuples {
+		if tuple.Address == nil {
+			return nil, fmt.Errorf("missing address in access list entry %d", i)
+		}
+		keys := make([]common.Hash, 0, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			if len(key) > common.HashLength {
+				return nil, fmt.Errorf("storage key in access list entry %d exceeds %d bytes", i, common.HashLength)
+			}
+			if strict && len(key) < common.HashLength {
+				return nil, fmt.Errorf("%w: %d bytes in access list entry %d", errShortStorageKey, len(key), i)
+			}
+			keys = append(keys, common.BytesToHash(key))
+		}
+		accessList = append(accessList, AccessTuple{Address: *tuple.Address, StorageKeys: keys})
+	}
+	return accessList, nil
+}
+
 // TxInternalDataEthereumAccessList is the data of EIP-2930 access list transactions.
 type TxInternalDataEthereumAccessList struct {
 	ChainID      *big.Int
@@ -216,6 +291,8 @@ func (t *TxInternalDataEthereumAccessList) GetRoleTypeForValidation() accountkey
 	return accountkey.RoleTransaction
 }
 
+// ChainId returns the chain ID carried by the transaction. Unlike a legacy transaction, it is never
+// derived from V, which is only the y-parity of the signature. It is nil only if the chain ID is unset.
 func (t *TxInternalDataEthereumAccessList) ChainId() *big.Int {
 	return t.ChainID
 }
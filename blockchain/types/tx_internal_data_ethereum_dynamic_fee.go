@@ -17,15 +17,21 @@
 package types
 
 import (
+	"bytes"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/common/math"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/fork"
 	"github.com/klaytn/klaytn/kerrors"
@@ -33,6 +39,64 @@ import (
 	"github.com/klaytn/klaytn/rlp"
 )
 
+// MinFeeBumpPercent is the minimum percentage by which the fees of a replacement
+// transaction must exceed those of the transaction being replaced.
+const MinFeeBumpPercent = 10
+
+// The fee regimes of a dynamic fee transaction returned by FeeRegime.
+const (
+	FeeRegimeUnderpriced  = "underpriced"   // the gas fee cap is below the base fee
+	FeeRegimeTipCapped    = "tip-capped"    // the gas fee cap leaves less than the gas tip cap above the base fee
+	FeeRegimeTipSatisfied = "tip-satisfied" // the whole gas tip cap is paid on top of the base fee
+)
+
+// estimatedSignatureSize is the RLP-encoded size of a typical signature: a single byte y-parity
+// followed by 32-byte R and S values with their string headers.
+const estimatedSignatureSize = 1 + 2*(1+32)
+
+var (
+	errFeeBumpTooLow      = fmt.Errorf("fee bump must be at least %d%%", MinFeeBumpPercent)
+	errFeeBumpOverflow    = errors.New("bumped fee exceeds 256 bits")
+	errSignerChainID      = errors.New("chain ID of the signer does not match")
+	errJSONTxType         = errors.New("typeInt does not match TxTypeEthereumDynamicFee")
+	errJSONNoChainID      = errors.New("missing required field 'chainId'")
+	errNoAccessListVM     = errors.New("the VM does not trace an access list")
+	errLegacyNotProtected = errors.New("legacy transaction is not replay-protected")
+	errNotDynamicFeeRawTx = errors.New("raw transaction is not a dynamic fee transaction")
+	errInvalidTip         = errors.New("gas tip cap must be a non-negative value")
+	errTipAbovePrice      = errors.New("gas tip cap is higher than the gas price of the legacy transaction")
+	errTraceGasTooLow     = errors.New("gas limit is lower than the intrinsic gas")
+	errSignNoChainID      = errors.New("chain ID is required to sign")
+	errSignerNoChainID    = errors.New("the signer has no chain ID")
+	errNoSnapshotState    = errors.New("the state does not support snapshots")
+	errGasLimitAboveBlock = errors.New("exceeds block gas limit")
+	errGasLimitIntrinsic  = errors.New("intrinsic gas too low")
+	errShortStorageKey    = errors.New("storage key is shorter than 32 bytes")
+
+	// ErrSenderMismatch is returned by VerifySender if the recovered sender is not the expected one.
+	ErrSenderMismatch = errors.New("sender does not match the expected address")
+	// ErrIntrinsicGasMismatch is returned by VerifyIntrinsicGas if the claimed intrinsic gas is not the computed one.
+	ErrIntrinsicGasMismatch = errors.New("intrinsic gas does not match the computed value")
+)
+
+// secp256k1HalfN is the half of the order of the secp256k1 curve, the upper bound of low-s signatures.
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+// createAddress returns the address of the contract created by the sender with the nonce.
+// It is a variable so that tests can force a creation address reserved for precompiled contracts.
+var createAddress = crypto.CreateAddress
+
+// SignatureCountError is returned when a transaction is given a different number of signatures than it takes.
+type SignatureCountError struct {
+	TxType   TxType
+	Expected int
+	Actual   int
+}
+
+func (e *SignatureCountError) Error() string {
+	return fmt.Sprintf("%s can receive only %d signature(s), but %d given", e.TxType, e.Expected, e.Actual)
+}
+
 type TxInternalDataEthereumDynamicFee struct {
 	ChainID      *big.Int
 	AccountNonce uint64
@@ -69,6 +133,36 @@ type TxInternalDataEthereumDynamicFeeJSON struct {
 	Hash                 *common.Hash     `json:"hash"`
 }
 
+// TxInternalDataEthereumDynamicFeeEthJSON is the JSON representation of the transaction
+// expected by Ethereum clients, which has the EIP-2718 type in hex and flat signature values.
+type TxInternalDataEthereumDynamicFeeEthJSON struct {
+	Type                 hexutil.Uint64  `json:"type"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+	AccountNonce         hexutil.Uint64  `json:"nonce"`
+	Recipient            *common.Address `json:"to"`
+	GasLimit             hexutil.Uint64  `json:"gas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	Amount               *hexutil.Big    `json:"value"`
+	Payload              hexutil.Bytes   `json:"input"`
+	AccessList           AccessList      `json:"accessList"`
+	V                    *hexutil.Big    `json:"v"`
+	R                    *hexutil.Big    `json:"r"`
+	S                    *hexutil.Big    `json:"s"`
+	YParity              *hexutil.Big    `json:"yParity"`
+	Hash                 *common.Hash    `json:"hash,omitempty"`
+}
+
+// TxAnnouncement is a compact summary of a dynamic fee transaction gossiped in place of the
+// whole transaction. It can be RLP-encoded since its size is an integer.
+type TxAnnouncement struct {
+	Hash      common.Hash
+	Nonce     uint64
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+	Size      uint64
+}
+
 func newEmptyTxInternalDataEthereumDynamicFee() *TxInternalDataEthereumDynamicFee {
 	return &TxInternalDataEthereumDynamicFee{}
 }
@@ -149,6 +243,9 @@ func newTxInternalDataEthereumDynamicFeeWithMap(values map[TxValueKeyType]interf
 	}
 
 	if v, ok := values[TxValueKeyAmount].(*big.Int); ok {
+		if v.Sign() < 0 {
+			return nil, errValueKeyAmountMustNonNegative
+		}
 		d.Amount.Set(v)
 		delete(values, TxValueKeyAmount)
 	} else {
@@ -199,6 +296,128 @@ func newTxInternalDataEthereumDynamicFeeWithMap(values map[TxValueKeyType]interf
 	return d, nil
 }
 
+// FromLegacy converts a legacy transaction into a dynamic fee transaction paying the given tip.
+// The gas price of the legacy transaction becomes the gas fee cap, and the nonce, the gas limit,
+// the recipient, the value and the payload are preserved. The chain ID is taken from the
+// EIP-155 signature of the legacy transaction, and the signature is cleared since the
+// converted transaction has a different signature hash and must be signed again.
+func FromLegacy(legacy *TxInternalDataLegacy, tip *big.Int) (*TxInternalDataEthereumDynamicFee, error) {
+	if !isProtectedV(legacy.V) {
+		return nil, errLegacyNotProtected
+	}
+	if tip == nil || tip.Sign() < 0 {
+		return nil, errInvalidTip
+	}
+	if tip.Cmp(legacy.Price) > 0 {
+		return nil, errTipAbovePrice
+	}
+
+	var recipient *common.Address
+	if legacy.Recipient != nil {
+		to := *legacy.Recipient
+		recipient = &to
+	}
+	return newTxInternalDataEthereumDynamicFeeWithValues(legacy.AccountNonce, recipient, legacy.Amount, legacy.GasLimit,
+		tip, legacy.Price, legacy.Payload, AccessList{}, legacy.ChainId()), nil
+}
+
+// DecodeDynamicFeeRawTx decodes a raw dynamic fee transaction and recovers its sender.
+// The raw transaction is the type byte 0x02 followed by the RLP-encoded fields as defined by EIP-2718,
+// optionally preceded by the Klaytn envelope type byte 0x78.
+func DecodeDynamicFeeRawTx(raw []byte) (*TxInternalDataEthereumDynamicFee, common.Address, error) {
+	if len(raw) > 0 && raw[0] == byte(EthereumTxTypeEnvelope) {
+		raw = raw[1:]
+	}
+	if len(raw) == 0 {
+		return nil, common.Address{}, errNotDynamicFeeRawTx
+	}
+	if ethType := TxType(raw[0]); EthereumTxTypeEnvelope<<8|ethType != TxTypeEthereumDynamicFee {
+		return nil, common.Address{}, fmt.Errorf("%w: type byte 0x%02x", errNotDynamicFeeRawTx, raw[0])
+	}
+
+	t := newEmptyTxInternalDataEthereumDynamicFee()
+	if err := rlp.DecodeBytes(raw[1:], t); err != nil {
+		return nil, common.Address{}, err
+	}
+	from, err := Sender(LatestSignerForChainID(t.ChainID), NewTx(t))
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return t, from, nil
+}
+
+// EncodeDynamicFeeBatch encodes the transactions into a single RLP list of byte strings, each of which
+// is the type byte 0x02 followed by the RLP-encoded fields, as typed transactions are encoded in
+// Ethereum block bodies. It is decoded by DecodeDynamicFeeBatch.
+func EncodeDynamicFeeBatch(txs []*TxInternalDataEthereumDynamicFee) ([]byte, error) {
+	elems := make([][]byte, len(txs))
+	for i, t := range txs {
+		enc, err := rlp.EncodeToBytes(t)
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = append([]byte{byte(t.Type())}, enc...)
+	}
+	return rlp.EncodeToBytes(elems)
+}
+
+// DecodeDynamicFeeBatch decodes the transactions encoded by EncodeDynamicFeeBatch.
+// Unlike DecodeDynamicFeeRawTx, it does not recover the senders, and the elements must not be
+// preceded by the Klaytn envelope type byte.
+func DecodeDynamicFeeBatch(b []byte) ([]*TxInternalDataEthereumDynamicFee, error) {
+	var elems [][]byte
+	if err := rlp.DecodeBytes(b, &elems); err != nil {
+		return nil, err
+	}
+	txs := make([]*TxInternalDataEthereumDynamicFee, len(elems))
+	for i, elem := range elems {
+		if len(elem) == 0 || EthereumTxTypeEnvelope<<8|TxType(elem[0]) != TxTypeEthereumDynamicFee {
+			return nil, fmt.Errorf("%w: element %d", errNotDynamicFeeRawTx, i)
+		}
+		t := newEmptyTxInternalDataEthereumDynamicFee()
+		if err := rlp.DecodeBytes(elem[1:], t); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		txs[i] = t
+	}
+	return txs, nil
+}
+
+// AnnounceSummary returns the summary of the transaction announced to peers.
+// The size is the RLP-encoded size of the transaction, the same as Transaction.Size.
+func (t *TxInternalDataEthereumDynamicFee) AnnounceSummary() TxAnnouncement {
+	return TxAnnouncement{
+		Hash:      t.TxHash(),
+		Nonce:     t.AccountNonce,
+		GasFeeCap: new(big.Int).Set(t.GasFeeCap),
+		GasTipCap: new(big.Int).Set(t.GasTipCap),
+		Size:      uint64(calculateTxSize(t)),
+	}
+}
+
+// EstimatedSize returns the approximate RLP-encoded size of the transaction once it is signed,
+// which is comparable to Transaction.Size. The signature is assumed to have 32-byte R and S values,
+// so the estimate may be a few bytes larger than the actual size if they have leading zeros.
+func (t *TxInternalDataEthereumDynamicFee) EstimatedSize() int {
+	c := writeCounter(0)
+	for _, field := range t.SerializeForSign() {
+		rlp.Encode(&c, field)
+	}
+	return int(rlp.ListSize(uint64(c) + estimatedSignatureSize))
+}
+
+// PoolKey returns the key identifying the transaction by its sender and nonce in a tx pool,
+// formatted as the lower-case hex address of the sender, a colon and the decimal nonce.
+func (t *TxInternalDataEthereumDynamicFee) PoolKey(from common.Address) string {
+	// 0x + 40 hex digits + colon + up to 20 digits of uint64
+	buf := make([]byte, 2+2*common.AddressLength+1, 2+2*common.AddressLength+1+20)
+	copy(buf, "0x")
+	hex.Encode(buf[2:], from[:])
+	buf[len(buf)-1] = ':'
+	buf = strconv.AppendUint(buf, t.AccountNonce, 10)
+	return string(buf)
+}
+
 func (t *TxInternalDataEthereumDynamicFee) Type() TxType {
 	return TxTypeEthereumDynamicFee
 }
@@ -223,6 +442,11 @@ func (t *TxInternalDataEthereumDynamicFee) GetRecipient() *common.Address {
 	return t.Recipient
 }
 
+// IsContractCreation returns true if the transaction has no recipient, so it deploys a contract.
+func (t *TxInternalDataEthereumDynamicFee) IsContractCreation() bool {
+	return t.Recipient == nil
+}
+
 func (t *TxInternalDataEthereumDynamicFee) GetAmount() *big.Int {
 	return new(big.Int).Set(t.Amount)
 }
@@ -235,16 +459,229 @@ func (t *TxInternalDataEthereumDynamicFee) GetPayload() []byte {
 	return t.Payload
 }
 
+// PayloadByteStats returns the number of zero and non-zero bytes in the payload,
+// which are priced differently by the calldata gas before the Istanbul hardfork.
+func (t *TxInternalDataEthereumDynamicFee) PayloadByteStats() (zero, nonzero int) {
+	return countPayloadBytes(t.Payload)
+}
+
 func (t *TxInternalDataEthereumDynamicFee) GetAccessList() AccessList {
 	return t.AccessList
 }
 
+// StorageKeyCount returns the total number of storage keys in the access list.
+func (t *TxInternalDataEthereumDynamicFee) StorageKeyCount() int {
+	return t.AccessList.StorageKeys()
+}
+
+// PrecompiledAccessListAddresses returns the precompiled contract addresses in the access list.
+// Such entries only waste gas since precompiled contracts are always warm, so wallets and other
+// tools can warn about or reject them before submitting the transaction. They are valid in a block.
+func (t *TxInternalDataEthereumDynamicFee) PrecompiledAccessListAddresses() []common.Address {
+	var addrs []common.Address
+	for _, tuple := range t.AccessList {
+		if common.IsPrecompiledContractAddress(tuple.Address) {
+			addrs = append(addrs, tuple.Address)
+		}
+	}
+	return addrs
+}
+
+// RecipientWarmthGasSaving returns the EIP-2929 gas saved on the first access to the recipient
+// by listing it in the access list, which is the cold account access cost less the warm read cost.
+// It does not subtract TxAccessListAddressGas paid for the listing. It is zero if the recipient
+// is not listed or the transaction is a contract creation.
+func (t *TxInternalDataEthereumDynamicFee) RecipientWarmthGasSaving() uint64 {
+	if t.Recipient == nil {
+		return 0
+	}
+	for _, tuple := range t.AccessList {
+		if tuple.Address == *t.Recipient {
+			return params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+		}
+	}
+	return 0
+}
+
+// AccessedAddresses returns the deduplicated addresses the transaction will touch:
+// the sender, the recipient unless it is a contract creation, and the addresses in the access list.
+func (t *TxInternalDataEthereumDynamicFee) AccessedAddresses(from common.Address) []common.Address {
+	seen := map[common.Address]struct{}{from: {}}
+	addrs := []common.Address{from}
+
+	add := func(addr common.Address) {
+		if _, ok := seen[addr]; !ok {
+			seen[addr] = struct{}{}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	if t.Recipient != nil {
+		add(*t.Recipient)
+	}
+	for _, tuple := range t.AccessList {
+		add(tuple.Address)
+	}
+
+	return addrs
+}
+
+// AccessListChunks partitions the access list into chunks of at most size entries preserving the order.
+// If size is not positive, the whole access list is returned as a single chunk.
+// The chunks share the underlying array of the access list.
+func (t *TxInternalDataEthereumDynamicFee) AccessListChunks(size int) []AccessList {
+	if size <= 0 {
+		return []AccessList{t.AccessList}
+	}
+
+	chunks := make([]AccessList, 0, (len(t.AccessList)+size-1)/size)
+	for start := 0; start < len(t.AccessList); start += size {
+		end := start + size
+		if end > len(t.AccessList) {
+			end = len(t.AccessList)
+		}
+		chunks = append(chunks, t.AccessList[start:end:end])
+	}
+	return chunks
+}
+
 func (t *TxInternalDataEthereumDynamicFee) GetGasTipCap() *big.Int {
-	return t.GasTipCap
+	return new(big.Int).Set(t.GasTipCap)
 }
 
 func (t *TxInternalDataEthereumDynamicFee) GetGasFeeCap() *big.Int {
-	return t.GasFeeCap
+	return new(big.Int).Set(t.GasFeeCap)
+}
+
+// MinTipForInclusion returns the tip left to the transaction when the base fee reaches the highest
+// value of the forecast, i.e., the gas fee cap minus the highest forecasted base fee. The gas fee cap
+// covers both the base fee and this tip over the whole forecast, so the transaction stays includable
+// with a positive tip. It returns nil if the gas fee cap cannot leave a positive tip.
+// A nil base fee is treated as zero.
+func (t *TxInternalDataEthereumDynamicFee) MinTipForInclusion(baseFees []*big.Int) *big.Int {
+	maxBaseFee := new(big.Int)
+	for _, baseFee := range baseFees {
+		if baseFee != nil && baseFee.Cmp(maxBaseFee) > 0 {
+			maxBaseFee = baseFee
+		}
+	}
+	tip := new(big.Int).Sub(t.GasFeeCap, maxBaseFee)
+	if tip.Sign() <= 0 {
+		return nil
+	}
+	return tip
+}
+
+// PriorityScore returns the effective gas tip for the given base fee, which is used to rank transactions.
+// It equals Transaction.EffectiveGasTip but is clamped at zero if the gas fee cap is below the base fee.
+// A nil base fee is treated as zero.
+func (t *TxInternalDataEthereumDynamicFee) PriorityScore(baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		baseFee = common.Big0
+	}
+	score := math.BigMin(t.GasTipCap, new(big.Int).Sub(t.GasFeeCap, baseFee))
+	if score.Sign() < 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(score)
+}
+
+// FeeRegime classifies the fees of the transaction for the given base fee into one of
+// FeeRegimeUnderpriced, FeeRegimeTipCapped and FeeRegimeTipSatisfied.
+// A nil base fee is treated as zero.
+func (t *TxInternalDataEthereumDynamicFee) FeeRegime(baseFee *big.Int) string {
+	if baseFee == nil {
+		baseFee = common.Big0
+	}
+	if t.GasFeeCap.Cmp(baseFee) < 0 {
+		return FeeRegimeUnderpriced
+	}
+	if new(big.Int).Sub(t.GasFeeCap, baseFee).Cmp(t.GasTipCap) < 0 {
+		return FeeRegimeTipCapped
+	}
+	return FeeRegimeTipSatisfied
+}
+
+// TxFeeUsage is a dynamic fee transaction with the gas it used in its block.
+type TxFeeUsage struct {
+	*TxInternalDataEthereumDynamicFee
+	GasUsed uint64
+}
+
+// NewTxFeeUsage returns the fee usage of the transaction with the gas used recorded in its receipt.
+func NewTxFeeUsage(t *TxInternalDataEthereumDynamicFee, receipt *Receipt) TxFeeUsage {
+	return TxFeeUsage{TxInternalDataEthereumDynamicFee: t, GasUsed: receipt.GasUsed}
+}
+
+// FeeContribution returns what the transaction contributes to the fee history of its block with the
+// given base fee, as reported by eth_feeHistory. Like eth_feeHistory, the reward is not the tip, which
+// Klaytn does not pay, but the base fee of the block, or the gas fee cap if the base fee is nil since
+// the gas fee cap must be the unit price before the Magma hardfork.
+// The gas used ratio is the share of the gas used by the transaction in params.UpperGasLimit,
+// which eth_feeHistory uses as the gas limit of a block.
+func (u TxFeeUsage) FeeContribution(baseFee *big.Int) (reward *big.Int, gasUsedRatio float64) {
+	if baseFee != nil {
+		reward = new(big.Int).Set(baseFee)
+	} else {
+		reward = new(big.Int).Set(u.GasFeeCap)
+	}
+	return reward, float64(u.GasUsed) / float64(params.UpperGasLimit)
+}
+
+// FeeRange returns the range of the fee the transaction pays for its whole gas limit.
+// The minimum is the fee at the effective gas price for the given base fee, which is the base fee itself
+// since the Magma hardfork, and the maximum is the fee at the gas fee cap. If the base fee is nil,
+// i.e., before the Magma hardfork, or exceeds the gas fee cap, the minimum equals the maximum;
+// the transaction is not includable in the latter case as reported by IsIncludable.
+func (t *TxInternalDataEthereumDynamicFee) FeeRange(baseFee *big.Int) (min, max *big.Int) {
+	gasLimit := new(big.Int).SetUint64(t.GasLimit)
+	max = new(big.Int).Mul(t.GasFeeCap, gasLimit)
+	if baseFee == nil || baseFee.Cmp(t.GasFeeCap) >= 0 {
+		return new(big.Int).Set(max), max
+	}
+	return new(big.Int).Mul(baseFee, gasLimit), max
+}
+
+// IsIncludable returns whether the transaction can be included in a block with the given base fee,
+// i.e., whether its gas fee cap covers the base fee. A nil base fee is treated as zero.
+func (t *TxInternalDataEthereumDynamicFee) IsIncludable(baseFee *big.Int) bool {
+	if baseFee == nil {
+		return true
+	}
+	return t.GasFeeCap.Cmp(baseFee) >= 0
+}
+
+// nonceReader is the part of the state IsNonceStale reads, so that it does not widen StateDB.
+type nonceReader interface {
+	GetNonce(common.Address) uint64
+}
+
+// IsNonceStale returns true if the nonce of the transaction is lower than the current nonce of
+// the sender, so the transaction can never be executed.
+func (t *TxInternalDataEthereumDynamicFee) IsNonceStale(stateDB nonceReader, from common.Address) bool {
+	return t.AccountNonce < stateDB.GetNonce(from)
+}
+
+// HasEnoughBalance returns whether the balance covers the maximum cost of the transaction,
+// GasFeeCap * GasLimit + Amount. If the base fee exceeds the gas fee cap, the base fee is
+// used instead since Klaytn charges the base fee. If the balance is insufficient, the shortfall is returned.
+func (t *TxInternalDataEthereumDynamicFee) HasEnoughBalance(balance *big.Int, baseFee *big.Int) (bool, *big.Int) {
+	price := t.GasFeeCap
+	if baseFee != nil && baseFee.Cmp(price) > 0 {
+		price = baseFee
+	}
+
+	// The computation is done with big.Int, so it cannot overflow.
+	cost := new(big.Int).Mul(price, new(big.Int).SetUint64(t.GasLimit))
+	cost.Add(cost, t.Amount)
+
+	if balance == nil {
+		balance = common.Big0
+	}
+	if balance.Cmp(cost) >= 0 {
+		return true, new(big.Int)
+	}
+	return false, cost.Sub(cost, balance)
 }
 
 func (t *TxInternalDataEthereumDynamicFee) SetHash(hash *common.Hash) {
@@ -252,24 +689,75 @@ func (t *TxInternalDataEthereumDynamicFee) SetHash(hash *common.Hash) {
 }
 
 func (t *TxInternalDataEthereumDynamicFee) SetSignature(signatures TxSignatures) {
+	if err := t.SetSignatureE(signatures); err != nil {
+		logger.Crit("TxTypeEthereumDynamicFee can receive only single signature!", "err", err)
+	}
+}
+
+// SetSignatureE sets the signature like SetSignature, but returns an error
+// instead of terminating the process if the number of signatures is not one.
+func (t *TxInternalDataEthereumDynamicFee) SetSignatureE(signatures TxSignatures) error {
 	if len(signatures) != 1 {
-		logger.Crit("TxTypeEthereumDynamicFee can receive only single signature!")
+		return &SignatureCountError{TxType: t.Type(), Expected: 1, Actual: len(signatures)}
 	}
 
 	t.V = signatures[0].V
 	t.R = signatures[0].R
 	t.S = signatures[0].S
+	t.InvalidateCaches()
+	return nil
+}
+
+// InvalidateCaches clears the hash set by SetHash, which is the only value derived from the fields
+// held by the transaction data itself. Every method mutating the transaction calls it, and external code
+// mutating the exported fields directly must call it as well so that a stale hash is not marshaled.
+// It does not clear the hash, size and sender cached by a Transaction wrapping the data,
+// so the data must not be mutated once wrapped; wrap the mutated data with NewTx instead.
+func (t *TxInternalDataEthereumDynamicFee) InvalidateCaches() {
+	t.Hash = nil
 }
 
 func (t *TxInternalDataEthereumDynamicFee) RawSignatureValues() TxSignatures {
 	return TxSignatures{&TxSignature{t.V, t.R, t.S}}
 }
 
+// Signature returns the single signature of the transaction.
+func (t *TxInternalDataEthereumDynamicFee) Signature() *TxSignature {
+	return &TxSignature{t.V, t.R, t.S}
+}
+
 func (t *TxInternalDataEthereumDynamicFee) ValidateSignature() bool {
 	v := byte(t.V.Uint64())
 	return crypto.ValidateSignatureValues(v, t.R, t.S, false)
 }
 
+// IsSignatureMalleable returns true if the S value of the signature is in the upper half of
+// the curve order, so that the same transaction has another valid signature with N - S.
+func (t *TxInternalDataEthereumDynamicFee) IsSignatureMalleable() bool {
+	return t.S != nil && t.S.Cmp(secp256k1HalfN) > 0
+}
+
+// NormalizeSignature replaces a high-s signature with the equivalent low-s one, N - S with the flipped
+// V parity, which recovers the same sender. A low-s signature is left unchanged.
+// Since the transaction hash covers the signature, the hash changes if the signature is normalized.
+func (t *TxInternalDataEthereumDynamicFee) NormalizeSignature() {
+	if !t.IsSignatureMalleable() {
+		return
+	}
+	t.S = new(big.Int).Sub(crypto.S256().Params().N, t.S)
+	t.V = new(big.Int).Xor(t.V, common.Big1)
+	t.InvalidateCaches()
+}
+
+// ValidateLowSSignature validates the signature values rejecting high-s signatures as EIP-2 requires
+// for typed transactions. It is not gated by a hardfork since the signer has always recovered the sender
+// of a dynamic fee transaction with homestead=true, which rejects high-s signatures at any block.
+// ValidateSignature accepts them only to decode such transactions.
+func (t *TxInternalDataEthereumDynamicFee) ValidateLowSSignature() bool {
+	v := byte(t.V.Uint64())
+	return crypto.ValidateSignatureValues(v, t.R, t.S, true)
+}
+
 func (t *TxInternalDataEthereumDynamicFee) RecoverAddress(txhash common.Hash, homestead bool, vfunc func(*big.Int) *big.Int) (common.Address, error) {
 	V := vfunc(t.V)
 	return recoverPlain(txhash, t.R, t.S, V, homestead)
@@ -287,13 +775,121 @@ func (t *TxInternalDataEthereumDynamicFee) RecoverPubkey(txhash common.Hash, hom
 }
 
 func (t *TxInternalDataEthereumDynamicFee) IntrinsicGas(currentBlockNumber uint64) (uint64, error) {
-	return IntrinsicGas(t.Payload, t.AccessList, t.Recipient == nil, *fork.Rules(big.NewInt(int64(currentBlockNumber))))
+	breakdown, err := t.IntrinsicGasDetailed(currentBlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return breakdown.Total()
+}
+
+// IntrinsicGasDetailed returns the intrinsic gas of the transaction split into its components.
+// The sum of the components equals the value returned by IntrinsicGas.
+func (t *TxInternalDataEthereumDynamicFee) IntrinsicGasDetailed(currentBlockNumber uint64) (IntrinsicGasBreakdown, error) {
+	return IntrinsicGasDetailed(t.Payload, t.AccessList, t.IsContractCreation(), *fork.Rules(big.NewInt(int64(currentBlockNumber))))
+}
+
+// CalldataGas returns the gas charged for the payload bytes only, which is the Data component of
+// IntrinsicGasDetailed. Before the Istanbul hardfork, zero and non-zero bytes are priced differently;
+// since then, Klaytn charges TxDataGas per byte regardless of its value instead of the EIP-2028 cost.
+// It returns math.MaxUint64 if the cost overflows.
+func (t *TxInternalDataEthereumDynamicFee) CalldataGas(currentBlockNumber uint64) uint64 {
+	breakdown, err := t.IntrinsicGasDetailed(currentBlockNumber)
+	if err != nil {
+		return math.MaxUint64
+	}
+	return breakdown.Data
 }
 
+// ValidateGasLimit returns an error if the gas limit of the transaction exceeds the block gas limit
+// or is lower than the intrinsic gas at the given block.
+func (t *TxInternalDataEthereumDynamicFee) ValidateGasLimit(blockGasLimit uint64, currentBlockNumber uint64) error {
+	if t.GasLimit > blockGasLimit {
+		return errGasLimitAboveBlock
+	}
+	gas, err := t.IntrinsicGas(currentBlockNumber)
+	if err != nil {
+		return err
+	}
+	if t.GasLimit < gas {
+		return errGasLimitIntrinsic
+	}
+	return nil
+}
+
+// IntrinsicGasExceedsLimit returns true if the intrinsic gas of the transaction at the given block
+// is greater than its own gas limit, in which case the transaction can never be executed.
+func (t *TxInternalDataEthereumDynamicFee) IntrinsicGasExceedsLimit(currentBlockNumber uint64) (bool, error) {
+	gas, err := t.IntrinsicGas(currentBlockNumber)
+	if err != nil {
+		return false, err
+	}
+	return gas > t.GasLimit, nil
+}
+
+// VerifyIntrinsicGas returns an error wrapping ErrIntrinsicGasMismatch if the claimed intrinsic gas,
+// e.g., estimated by a client, differs from the intrinsic gas of the transaction at the given block.
+func (t *TxInternalDataEthereumDynamicFee) VerifyIntrinsicGas(claimed uint64, currentBlockNumber uint64) error {
+	gas, err := t.IntrinsicGas(currentBlockNumber)
+	if err != nil {
+		return err
+	}
+	if claimed != gas {
+		return fmt.Errorf("%w: claimed %d, computed %d", ErrIntrinsicGasMismatch, claimed, gas)
+	}
+	return nil
+}
+
+// MaxTxsInBlock returns how many transactions with the same gas limit fit in a block with the given
+// gas limit, which is at least 1. It returns math.MaxUint64 if the gas limit of the transaction is zero.
+func (t *TxInternalDataEthereumDynamicFee) MaxTxsInBlock(blockGasLimit uint64) uint64 {
+	if t.GasLimit == 0 {
+		return math.MaxUint64
+	}
+	if n := blockGasLimit / t.GasLimit; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// ChainId returns the chain ID carried by the transaction. Unlike a legacy transaction, it is never
+// derived from V, which is only the y-parity of the signature. It is nil only if the chain ID is unset.
 func (t *TxInternalDataEthereumDynamicFee) ChainId() *big.Int {
 	return t.ChainID
 }
 
+// ReSign replaces the chain ID of the transaction with newChainID and signs it again with the key.
+// The signer must be for the new chain ID. The old signature is cleared even if signing fails.
+func (t *TxInternalDataEthereumDynamicFee) ReSign(signer Signer, key *ecdsa.PrivateKey, newChainID *big.Int) error {
+	if signer == nil || signer.ChainID() == nil {
+		return errSignerNoChainID
+	}
+	if newChainID == nil || signer.ChainID().Cmp(newChainID) != 0 {
+		return errSignerChainID
+	}
+
+	t.setSignatureValues(new(big.Int).Set(newChainID), new(big.Int), new(big.Int), new(big.Int))
+
+	tx := &Transaction{data: t}
+	h := signer.Hash(tx)
+	sig, err := crypto.Sign(h[:], key)
+	if err != nil {
+		return err
+	}
+	r, s, v, err := signer.SignatureValues(tx, sig)
+	if err != nil {
+		return err
+	}
+	t.setSignatureValues(t.ChainID, v, r, s)
+
+	return nil
+}
+
+// Signer returns the signer for the chain ID of the transaction.
+// It should be used to recover the sender so that the signer is derived consistently.
+func (t *TxInternalDataEthereumDynamicFee) Signer() Signer {
+	return LatestSignerForChainID(t.ChainId())
+}
+
 func (t *TxInternalDataEthereumDynamicFee) Equal(a TxInternalData) bool {
 	ta, ok := a.(*TxInternalDataEthereumDynamicFee)
 	if !ok {
@@ -313,14 +909,173 @@ func (t *TxInternalDataEthereumDynamicFee) Equal(a TxInternalData) bool {
 		t.S.Cmp(ta.S) == 0
 }
 
+// EqualUnsigned compares all fields of the transaction except the signature values and the hash.
+// It is useful for deduplicating logically identical transactions regardless of who signed them.
+func (t *TxInternalDataEthereumDynamicFee) EqualUnsigned(a TxInternalData) bool {
+	ta, ok := a.(*TxInternalDataEthereumDynamicFee)
+	if !ok {
+		return false
+	}
+
+	return t.ChainID.Cmp(ta.ChainID) == 0 &&
+		t.AccountNonce == ta.AccountNonce &&
+		t.GasFeeCap.Cmp(ta.GasFeeCap) == 0 &&
+		t.GasTipCap.Cmp(ta.GasTipCap) == 0 &&
+		t.GasLimit == ta.GasLimit &&
+		equalRecipient(t.Recipient, ta.Recipient) &&
+		t.Amount.Cmp(ta.Amount) == 0 &&
+		bytes.Equal(t.Payload, ta.Payload) &&
+		reflect.DeepEqual(t.AccessList, ta.AccessList)
+}
+
+// EqualBytes returns whether the RLP encodings of the two transactions are the same.
+// It is faster than Equal for a large access list. It is a strict equality on the canonical encodings,
+// so every encoded field including the payload, which Equal ignores, must be the same,
+// while nil and empty values, which are encoded the same, are regarded as equal.
+func (t *TxInternalDataEthereumDynamicFee) EqualBytes(a TxInternalData) bool {
+	ta, ok := a.(*TxInternalDataEthereumDynamicFee)
+	if !ok {
+		return false
+	}
+
+	encT, err := rlp.EncodeToBytes(t)
+	if err != nil {
+		return false
+	}
+	encA, err := rlp.EncodeToBytes(ta)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(encT, encA)
+}
+
+// Diff returns the fields of the transaction which differ from other, keyed by the field names,
+// with the values of t and other in this order. It helps to debug a replacement transaction.
+// Unlike Equal, the payload is compared as well. The values are not copied.
+func (t *TxInternalDataEthereumDynamicFee) Diff(other *TxInternalDataEthereumDynamicFee) map[string][2]interface{} {
+	diff := make(map[string][2]interface{})
+	diffBig := func(name string, a, b *big.Int) {
+		if (a == nil) != (b == nil) || (a != nil && a.Cmp(b) != 0) {
+			diff[name] = [2]interface{}{a, b}
+		}
+	}
+
+	diffBig("ChainID", t.ChainID, other.ChainID)
+	if t.AccountNonce != other.AccountNonce {
+		diff["AccountNonce"] = [2]interface{}{t.AccountNonce, other.AccountNonce}
+	}
+	diffBig("GasTipCap", t.GasTipCap, other.GasTipCap)
+	diffBig("GasFeeCap", t.GasFeeCap, other.GasFeeCap)
+	if t.GasLimit != other.GasLimit {
+		diff["GasLimit"] = [2]interface{}{t.GasLimit, other.GasLimit}
+	}
+	if !equalRecipient(t.Recipient, other.Recipient) {
+		diff["Recipient"] = [2]interface{}{t.Recipient, other.Recipient}
+	}
+	diffBig("Amount", t.Amount, other.Amount)
+	if !bytes.Equal(t.Payload, other.Payload) {
+		diff["Payload"] = [2]interface{}{t.Payload, other.Payload}
+	}
+	if !reflect.DeepEqual(t.AccessList, other.AccessList) {
+		diff["AccessList"] = [2]interface{}{t.AccessList, other.AccessList}
+	}
+	diffBig("V", t.V, other.V)
+	diffBig("R", t.R, other.R)
+	diffBig("S", t.S, other.S)
+	return diff
+}
+
+// copyUnsigned returns a deep copy of the transaction without the signature values and the hash.
+func (t *TxInternalDataEthereumDynamicFee) copyUnsigned() *TxInternalDataEthereumDynamicFee {
+	cpy := newTxInternalDataEthereumDynamicFee()
+
+	cpy.AccountNonce = t.AccountNonce
+	cpy.GasLimit = t.GasLimit
+	if t.Recipient != nil {
+		to := *t.Recipient
+		cpy.Recipient = &to
+	}
+	if t.ChainID != nil {
+		cpy.ChainID.Set(t.ChainID)
+	}
+	if t.GasTipCap != nil {
+		cpy.GasTipCap.Set(t.GasTipCap)
+	}
+	if t.GasFeeCap != nil {
+		cpy.GasFeeCap.Set(t.GasFeeCap)
+	}
+	if t.Amount != nil {
+		cpy.Amount.Set(t.Amount)
+	}
+	cpy.Payload = common.CopyBytes(t.Payload)
+	for _, tuple := range t.AccessList {
+		cpy.AccessList = append(cpy.AccessList, AccessTuple{
+			Address:     tuple.Address,
+			StorageKeys: append([]common.Hash{}, tuple.StorageKeys...),
+		})
+	}
+
+	return cpy
+}
+
+// WithBumpedFees returns an unsigned copy of the transaction whose gas tip cap and gas fee cap
+// are increased by the given percentages. It can be used to build a replacement transaction,
+// so each bump must be at least MinFeeBumpPercent as the tx pool requires.
+func (t *TxInternalDataEthereumDynamicFee) WithBumpedFees(tipBumpPercent, feeCapBumpPercent int) (*TxInternalDataEthereumDynamicFee, error) {
+	if tipBumpPercent < MinFeeBumpPercent || feeCapBumpPercent < MinFeeBumpPercent {
+		return nil, errFeeBumpTooLow
+	}
+
+	bump := func(v *big.Int, percent int) (*big.Int, error) {
+		bumped := bumpByPercent(v, percent)
+		if bumped.BitLen() > 256 {
+			return nil, errFeeBumpOverflow
+		}
+		return bumped, nil
+	}
+
+	cpy := t.copyUnsigned()
+
+	tip, err := bump(cpy.GasTipCap, tipBumpPercent)
+	if err != nil {
+		return nil, err
+	}
+	feeCap, err := bump(cpy.GasFeeCap, feeCapBumpPercent)
+	if err != nil {
+		return nil, err
+	}
+	cpy.GasTipCap, cpy.GasFeeCap = tip, feeCap
+
+	return cpy, nil
+}
+
+// CanReplace returns whether the transaction can replace old in the tx pool.
+// Both transactions must have the same nonce, and both the gas tip cap and the gas fee cap
+// must be higher than those of old by at least minBumpPercent.
+func (t *TxInternalDataEthereumDynamicFee) CanReplace(old *TxInternalDataEthereumDynamicFee, minBumpPercent int) bool {
+	if t.AccountNonce != old.AccountNonce {
+		return false
+	}
+	if t.GasTipCap.Cmp(old.GasTipCap) <= 0 || t.GasFeeCap.Cmp(old.GasFeeCap) <= 0 {
+		return false
+	}
+	return t.GasTipCap.Cmp(bumpByPercent(old.GasTipCap, minBumpPercent)) >= 0 &&
+		t.GasFeeCap.Cmp(bumpByPercent(old.GasFeeCap, minBumpPercent)) >= 0
+}
+
+// bumpByPercent returns v * (100 + percent) / 100.
+func bumpByPercent(v *big.Int, percent int) *big.Int {
+	bumped := new(big.Int).Mul(v, big.NewInt(int64(100+percent)))
+	return bumped.Div(bumped, common.Big100)
+}
+
 func (t *TxInternalDataEthereumDynamicFee) String() string {
 	var from, to string
 	tx := &Transaction{data: t}
 
 	v, r, s := t.V, t.R, t.S
 
-	signer := LatestSignerForChainID(t.ChainId())
-	if f, err := Sender(signer, tx); err != nil { // derive but don't cache
+	if f, err := Sender(t.Signer(), tx); err != nil { // derive but don't cache
 		from = "[invalid sender: invalid sig]"
 	} else {
 		from = fmt.Sprintf("%x", f[:])
@@ -384,6 +1139,22 @@ func (t *TxInternalDataEthereumDynamicFee) SerializeForSign() []interface{} {
 	}
 }
 
+// UnsignedBytes returns the bytes whose hash is signed to sign the transaction for the given chain ID,
+// i.e., the type byte 0x02 followed by the RLP encoding of the fields returned by SerializeForSign.
+func (t *TxInternalDataEthereumDynamicFee) UnsignedBytes(chainID *big.Int) ([]byte, error) {
+	if chainID == nil {
+		return nil, errSignNoChainID
+	}
+	infs := t.SerializeForSign()
+	infs[0] = chainID
+
+	enc, err := rlp.EncodeToBytes(infs)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{byte(t.Type())}, enc...), nil
+}
+
 func (t *TxInternalDataEthereumDynamicFee) TxHash() common.Hash {
 	return prefixedRlpHash(byte(t.Type()), []interface{}{
 		t.ChainID,
@@ -418,12 +1189,28 @@ func (t *TxInternalDataEthereumDynamicFee) SenderTxHash() common.Hash {
 	})
 }
 
+// SemanticFingerprint returns the hash of the sender, nonce, recipient, value and payload of the transaction.
+// Unlike SenderTxHash, it excludes the gas and fee fields and the signature, so the transactions
+// making the same call with different fees, e.g., a transaction and its replacement, have the same fingerprint.
+func (t *TxInternalDataEthereumDynamicFee) SemanticFingerprint(from common.Address) common.Hash {
+	return rlpHash([]interface{}{
+		from,
+		t.AccountNonce,
+		t.Recipient,
+		t.Amount,
+		t.Payload,
+	})
+}
+
 func (t *TxInternalDataEthereumDynamicFee) Validate(stateDB StateDB, currentBlockNumber uint64) error {
 	if t.Recipient != nil {
 		if common.IsPrecompiledContractAddress(*t.Recipient) {
 			return kerrors.ErrPrecompiledContractAddress
 		}
 	}
+	if !t.ValidateLowSSignature() {
+		return ErrInvalidSig
+	}
 	return t.ValidateMutableValue(stateDB, currentBlockNumber)
 }
 
@@ -435,12 +1222,33 @@ func (t *TxInternalDataEthereumDynamicFee) IsLegacyTransaction() bool {
 	return false
 }
 
+// FillContractAddress fills the receipt with the address of the contract created by the transaction itself.
+// The address follows the CREATE semantics, so it depends only on the sender and the nonce.
+// Contracts deployed through a factory using CREATE2 can be predicted by PredictCreate2Address.
 func (t *TxInternalDataEthereumDynamicFee) FillContractAddress(from common.Address, r *Receipt) {
-	if t.Recipient == nil {
+	if t.IsContractCreation() {
 		r.ContractAddress = crypto.CreateAddress(from, t.AccountNonce)
 	}
 }
 
+// ValidateContractAddress returns ErrPrecompiledContractAddress if the contract created by the
+// transaction from the sender would land on an address reserved for precompiled contracts.
+// It is not a part of Validate since it does not change which transactions are valid in a block;
+// the tx pool and other tools can call it to reject such a transaction before it is submitted.
+func (t *TxInternalDataEthereumDynamicFee) ValidateContractAddress(from common.Address) error {
+	if t.IsContractCreation() && common.IsPrecompiledContractAddress(createAddress(from, t.AccountNonce)) {
+		return kerrors.ErrPrecompiledContractAddress
+	}
+	return nil
+}
+
+// PredictCreate2Address returns the address of a contract deployed with CREATE2 by the given deployer.
+// Unlike FillContractAddress, the address does not depend on the nonce of the transaction,
+// but on the salt and the hash of the init code passed to CREATE2.
+func (t *TxInternalDataEthereumDynamicFee) PredictCreate2Address(from common.Address, salt common.Hash, initCodeHash common.Hash) common.Address {
+	return crypto.CreateAddress2(from, salt, initCodeHash.Bytes())
+}
+
 func (t *TxInternalDataEthereumDynamicFee) Execute(sender ContractRef, vm VM, stateDB StateDB, currentBlockNumber uint64, gas uint64, value *big.Int) (ret []byte, usedGas uint64, err error) {
 	///////////////////////////////////////////////////////
 	// OpcodeComputationCostLimit: The below code is commented and will be usd for debugging purposes.
@@ -450,7 +1258,7 @@ func (t *TxInternalDataEthereumDynamicFee) Execute(sender ContractRef, vm VM, st
 	//	logger.Debug("[TxInternalDataLegacy] EVM execution done", "elapsed", elapsed)
 	//}()
 	///////////////////////////////////////////////////////
-	if t.Recipient == nil {
+	if t.IsContractCreation() {
 		// Sender's nonce will be increased in '`vm.Create()`
 		ret, _, usedGas, err = vm.Create(sender, t.Payload, gas, value, params.CodeFormatEVM)
 	} else {
@@ -460,6 +1268,266 @@ func (t *TxInternalDataEthereumDynamicFee) Execute(sender ContractRef, vm VM, st
 	return ret, usedGas, err
 }
 
+// SimulateExecute executes the transaction like Execute, but always reverts the changes made to
+// the stateDB, so that a call or a gas estimation has no side effect. The stateDB must be the one
+// the VM works on. Unlike Execute which returns the remaining gas, it returns the gas used.
+func (t *TxInternalDataEthereumDynamicFee) SimulateExecute(sender ContractRef, vm VM, stateDB StateDB, currentBlockNumber uint64, gas uint64, value *big.Int) (ret []byte, usedGas uint64, err error) {
+	snapshotDB, ok := stateDB.(SnapshotStateDB)
+	if !ok {
+		return nil, 0, errNoSnapshotState
+	}
+	snapshot := snapshotDB.Snapshot()
+	defer snapshotDB.RevertToSnapshot(snapshot)
+
+	ret, leftOverGas, err := t.Execute(sender, vm, stateDB, currentBlockNumber, gas, value)
+	return ret, gas - leftOverGas, err
+}
+
+// GenerateAccessList executes the transaction sent by from with an access list tracing VM,
+// and returns the accounts and storage slots touched during the execution together with
+// the intrinsic gas of the transaction carrying the generated access list.
+// The addresses excluded from the list, such as the sender, the recipient and the precompiles,
+// are decided by the tracer of the VM. The execution modifies the stateDB, so a copy should be given.
+func (t *TxInternalDataEthereumDynamicFee) GenerateAccessList(stateDB StateDB, vm VM, from common.Address) (AccessList, uint64, error) {
+	tracingVM, ok := vm.(AccessListTracingVM)
+	if !ok {
+		return nil, 0, errNoAccessListVM
+	}
+	rules := tracingVM.Rules()
+	isCreation := t.IsContractCreation()
+
+	gas, err := IntrinsicGas(t.Payload, t.AccessList, isCreation, rules)
+	if err != nil {
+		return nil, 0, err
+	}
+	if t.GasLimit < gas {
+		return nil, 0, errTraceGasTooLow
+	}
+
+	sender := NewAccountRefWithFeePayer(from, from)
+	if _, _, err := t.Execute(sender, vm, stateDB, 0, t.GasLimit-gas, t.Amount); err != nil {
+		return nil, 0, err
+	}
+
+	accessList, ok := tracingVM.TracedAccessList()
+	if !ok {
+		return nil, 0, errNoAccessListVM
+	}
+	gas, err = IntrinsicGas(t.Payload, accessList, isCreation, rules)
+	if err != nil {
+		return nil, 0, err
+	}
+	return accessList, gas, nil
+}
+
+// IsAccessListGasEfficient simulates the transaction with an access list tracing VM and reports
+// whether the declared access list saves gas compared to declaring nothing. It also returns the optimal
+// access list, which consists of the accounts and storage slots touched during the execution.
+// Each declared entry which is touched saves the difference between the cold and warm access costs
+// minus its declaration cost, while an untouched entry, or the sender and the recipient which are
+// always warm, only costs its declaration.
+// The tracer of the VM must be created without the declared access list, otherwise every declared
+// entry is regarded as touched. The changes made to the stateDB are reverted.
+// It returns false and nil if the simulation fails.
+func (t *TxInternalDataEthereumDynamicFee) IsAccessListGasEfficient(stateDB StateDB, vm VM, from common.Address) (bool, AccessList) {
+	snapshotDB, ok := stateDB.(SnapshotStateDB)
+	if !ok {
+		return false, nil
+	}
+	snapshot := snapshotDB.Snapshot()
+	defer snapshotDB.RevertToSnapshot(snapshot)
+
+	touched, _, err := t.GenerateAccessList(stateDB, vm, from)
+	if err != nil {
+		return false, nil
+	}
+
+	touchedSlots := make(map[common.Address]map[common.Hash]struct{}, len(touched))
+	for _, tuple := range touched {
+		slots := make(map[common.Hash]struct{}, len(tuple.StorageKeys))
+		for _, key := range tuple.StorageKeys {
+			slots[key] = struct{}{}
+		}
+		touchedSlots[tuple.Address] = slots
+	}
+
+	// A touched entry saves gas only once even if it is declared multiple times.
+	var (
+		saved, spent uint64
+		warmed       = make(map[common.Address]bool, len(t.AccessList))
+	)
+	for _, tuple := range t.AccessList {
+		spent += params.TxAccessListAddressGas + uint64(len(tuple.StorageKeys))*params.TxAccessListStorageKeyGas
+		slots, ok := touchedSlots[tuple.Address]
+		if !ok {
+			continue
+		}
+		// The sender and the recipient are always warm.
+		alwaysWarm := tuple.Address == from || (t.Recipient != nil && tuple.Address == *t.Recipient)
+		if !alwaysWarm && !warmed[tuple.Address] {
+			saved += params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+			warmed[tuple.Address] = true
+		}
+		for _, key := range tuple.StorageKeys {
+			if _, ok := slots[key]; ok {
+				saved += params.ColdSloadCostEIP2929 - params.WarmStorageReadCostEIP2929
+				delete(slots, key)
+			}
+		}
+	}
+	return saved > spent, touched
+}
+
+// DecodeFromStream decodes the RLP-encoded transaction from the stream directly into t.
+// Unlike rlp.Decode, it reuses the integers, the payload and the access list already held by t,
+// so decoding a large batch of transactions into the same object avoids most allocations.
+// Values previously obtained from t are overwritten and must be copied if they are kept, and so are
+// values t took from elsewhere, e.g., the signature values given to SetSignature.
+// The chain ID and the recipient are always allocated since they are usually shared with others,
+// e.g., the chain ID of the signer set by signing and the recipient given to the constructor.
+func (t *TxInternalDataEthereumDynamicFee) DecodeFromStream(s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+
+	var err error
+	if t.ChainID, err = readBigIntReuse(s, nil); err != nil {
+		return err
+	}
+	if t.AccountNonce, err = s.Uint64(); err != nil {
+		return err
+	}
+	if t.GasTipCap, err = readBigIntReuse(s, t.GasTipCap); err != nil {
+		return err
+	}
+	if t.GasFeeCap, err = readBigIntReuse(s, t.GasFeeCap); err != nil {
+		return err
+	}
+	if t.GasLimit, err = s.Uint64(); err != nil {
+		return err
+	}
+	if err = t.decodeRecipient(s); err != nil {
+		return err
+	}
+	if t.Amount, err = readBigIntReuse(s, t.Amount); err != nil {
+		return err
+	}
+	if t.Payload, err = readBytesReuse(s, t.Payload); err != nil {
+		return err
+	}
+	if err = t.decodeAccessList(s); err != nil {
+		return err
+	}
+	if t.V, err = readBigIntReuse(s, t.V); err != nil {
+		return err
+	}
+	if t.R, err = readBigIntReuse(s, t.R); err != nil {
+		return err
+	}
+	if t.S, err = readBigIntReuse(s, t.S); err != nil {
+		return err
+	}
+	t.InvalidateCaches()
+
+	return s.ListEnd()
+}
+
+func (t *TxInternalDataEthereumDynamicFee) decodeRecipient(s *rlp.Stream) error {
+	kind, size, err := s.Kind()
+	if err != nil {
+		return err
+	}
+	// An empty string means contract creation.
+	if kind == rlp.String && size == 0 {
+		t.Recipient = nil
+		_, err = s.Bytes()
+		return err
+	}
+	t.Recipient = new(common.Address)
+	return s.ReadBytes(t.Recipient[:])
+}
+
+func (t *TxInternalDataEthereumDynamicFee) decodeAccessList(s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+
+	list := t.AccessList[:0]
+	if list == nil {
+		list = AccessList{}
+	}
+	for s.MoreDataInList() {
+		// Reuse the storage keys of the tuple previously held at the same position.
+		var tuple AccessTuple
+		if len(list) < cap(list) {
+			tuple = list[:len(list)+1][len(list)]
+		}
+
+		if _, err := s.List(); err != nil {
+			return err
+		}
+		if err := s.ReadBytes(tuple.Address[:]); err != nil {
+			return err
+		}
+		if _, err := s.List(); err != nil {
+			return err
+		}
+		keys := tuple.StorageKeys[:0]
+		if keys == nil {
+			keys = []common.Hash{}
+		}
+		for s.MoreDataInList() {
+			var key common.Hash
+			if err := s.ReadBytes(key[:]); err != nil {
+				return err
+			}
+			keys = append(keys, key)
+		}
+		if err := s.ListEnd(); err != nil {
+			return err
+		}
+		if err := s.ListEnd(); err != nil {
+			return err
+		}
+
+		tuple.StorageKeys = keys
+		list = append(list, tuple)
+	}
+	t.AccessList = list
+
+	return s.ListEnd()
+}
+
+// readBigIntReuse decodes an integer into dst, allocating a new one only if dst is nil.
+func readBigIntReuse(s *rlp.Stream, dst *big.Int) (*big.Int, error) {
+	if dst == nil {
+		dst = new(big.Int)
+	}
+	if err := s.ReadBigInt(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// readBytesReuse decodes a byte string into the backing array of dst if it has enough capacity.
+func readBytesReuse(s *rlp.Stream, dst []byte) ([]byte, error) {
+	kind, size, err := s.Kind()
+	if err != nil {
+		return nil, err
+	}
+	if kind == rlp.Byte {
+		size = 1
+	}
+	if dst == nil || uint64(cap(dst)) < size {
+		dst = make([]byte, size)
+	}
+	dst = dst[:size]
+	if err := s.ReadBytes(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
 func (t *TxInternalDataEthereumDynamicFee) MakeRPCOutput() map[string]interface{} {
 	return map[string]interface{}{
 		"typeInt":              t.Type(),
@@ -477,6 +1545,45 @@ func (t *TxInternalDataEthereumDynamicFee) MakeRPCOutput() map[string]interface{
 	}
 }
 
+// MakeLightRPCOutput returns a reduced RPC output having only the hash, nonce, recipient, value and type
+// of the transaction for bandwidth-constrained light clients.
+// Every field has the same value as the one in the RPC output served by the API.
+func (t *TxInternalDataEthereumDynamicFee) MakeLightRPCOutput() map[string]interface{} {
+	return map[string]interface{}{
+		"hash":  t.TxHash(),
+		"type":  t.Type().String(),
+		"nonce": hexutil.Uint64(t.AccountNonce),
+		"to":    t.Recipient,
+		"value": (*hexutil.Big)(t.Amount),
+	}
+}
+
+// MakeRPCOutputWithFrom returns the same output as MakeRPCOutput with the sender address
+// recovered by the given signer.
+func (t *TxInternalDataEthereumDynamicFee) MakeRPCOutputWithFrom(signer Signer) (map[string]interface{}, error) {
+	from, err := Sender(signer, &Transaction{data: t})
+	if err != nil {
+		return nil, err
+	}
+
+	output := t.MakeRPCOutput()
+	output["from"] = from
+	return output, nil
+}
+
+// VerifySender recovers the sender with the given signer and checks that it is the expected address.
+// It is used to reject a claimed sender which does not match the signature of a raw transaction.
+func (t *TxInternalDataEthereumDynamicFee) VerifySender(signer Signer, expected common.Address) error {
+	from, err := Sender(signer, &Transaction{data: t})
+	if err != nil {
+		return err
+	}
+	if from != expected {
+		return fmt.Errorf("%w: expected %s, recovered %s", ErrSenderMismatch, expected.Hex(), from.Hex())
+	}
+	return nil
+}
+
 func (t *TxInternalDataEthereumDynamicFee) MarshalJSON() ([]byte, error) {
 	return json.Marshal(TxInternalDataEthereumDynamicFeeJSON{
 		t.Type(),
@@ -495,11 +1602,97 @@ func (t *TxInternalDataEthereumDynamicFee) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// StructuredDisplay returns the fields of the transaction in a human-readable form for signing
+// confirmation UIs. The value is in KLAY and the fees are in ston (10^9 peb, the Klaytn counterpart
+// of gwei), written as exact decimal strings with the unit. The recipient is nil for a contract creation.
+func (t *TxInternalDataEthereumDynamicFee) StructuredDisplay() map[string]interface{} {
+	var to interface{}
+	if t.Recipient != nil {
+		to = t.Recipient.Hex()
+	}
+	return map[string]interface{}{
+		"type":                 t.Type().String(),
+		"chainId":              t.ChainID.String(),
+		"nonce":                t.AccountNonce,
+		"to":                   to,
+		"value":                formatDenomination(t.Amount, params.KLAY, 18) + " KLAY",
+		"gas":                  t.GasLimit,
+		"maxFeePerGas":         formatDenomination(t.GasFeeCap, params.Ston, 9) + " ston",
+		"maxPriorityFeePerGas": formatDenomination(t.GasTipCap, params.Ston, 9) + " ston",
+		"data":                 hexutil.Encode(t.Payload),
+		"accessList":           t.AccessList,
+	}
+}
+
+// formatDenomination returns the amount in peb as an exact decimal number of the unit, which is
+// 10^decimals peb, without trailing zeros in the fraction.
+func formatDenomination(amount *big.Int, unit int64, decimals int) string {
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(amount), big.NewInt(unit), new(big.Int))
+	if r.Sign() == 0 {
+		return sign + q.String()
+	}
+	frac := strings.TrimRight(fmt.Sprintf("%0*s", decimals, r.String()), "0")
+	return sign + q.String() + "." + frac
+}
+
+// EthCompatibleMarshalJSON returns the JSON encoding of the transaction in the Ethereum format,
+// where the type is the EIP-2718 type in hex instead of the Klaytn type.
+func (t *TxInternalDataEthereumDynamicFee) EthCompatibleMarshalJSON() ([]byte, error) {
+	return json.Marshal(TxInternalDataEthereumDynamicFeeEthJSON{
+		hexutil.Uint64(byte(t.Type())),
+		(*hexutil.Big)(t.ChainID),
+		(hexutil.Uint64)(t.AccountNonce),
+		t.Recipient,
+		(hexutil.Uint64)(t.GasLimit),
+		(*hexutil.Big)(t.GasTipCap),
+		(*hexutil.Big)(t.GasFeeCap),
+		(*hexutil.Big)(t.Amount),
+		t.Payload,
+		t.AccessList,
+		(*hexutil.Big)(t.V),
+		(*hexutil.Big)(t.R),
+		(*hexutil.Big)(t.S),
+		(*hexutil.Big)(t.V),
+		t.Hash,
+	})
+}
+
 func (t *TxInternalDataEthereumDynamicFee) UnmarshalJSON(bytes []byte) error {
-	js := &TxInternalDataEthereumDynamicFeeJSON{}
-	if err := json.Unmarshal(bytes, js); err != nil {
+	// The access list is decoded separately to accept the Ethereum RPC format as well.
+	// Unlike ParseEthAccessList, short storage keys are rejected since a transaction signs 32-byte keys.
+	var dec struct {
+		TxInternalDataEthereumDynamicFeeJSON
+		AccessList json.RawMessage `json:"accessList"`
+	}
+	if err := json.Unmarshal(bytes, &dec); err != nil {
 		return err
 	}
+	js := &dec.TxInternalDataEthereumDynamicFeeJSON
+	if js.Type != t.Type() {
+		return errJSONTxType
+	}
+	if js.ChainID == nil {
+		return errJSONNoChainID
+	}
+	if len(dec.AccessList) > 0 {
+		if err := json.Unmarshal(dec.AccessList, &js.AccessList); err != nil {
+			accessList, ethErr := parseEthAccessList(dec.AccessList, true)
+			if errors.Is(ethErr, errShortStorageKey) {
+				return ethErr
+			}
+			if ethErr != nil {
+				return err
+			}
+			js.AccessList = accessList
+		}
+	}
+	if len(js.TxSignatures) != 1 {
+		return &SignatureCountError{TxType: t.Type(), Expected: 1, Actual: len(js.TxSignatures)}
+	}
 
 	t.ChainID = (*big.Int)(js.ChainID)
 	t.AccountNonce = uint64(js.AccountNonce)
@@ -520,4 +1713,5 @@ func (t *TxInternalDataEthereumDynamicFee) UnmarshalJSON(bytes []byte) error {
 
 func (t *TxInternalDataEthereumDynamicFee) setSignatureValues(chainID, v, r, s *big.Int) {
 	t.ChainID, t.V, t.R, t.S = chainID, v, r, s
+	t.InvalidateCaches()
 }
@@ -0,0 +1,135 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+var (
+	errBuilderNoChainID  = errors.New("chain ID is required")
+	errBuilderNoGasLimit = errors.New("gas limit is required")
+	errBuilderNoTip      = errors.New("gas tip cap is required")
+	errBuilderNoFeeCap   = errors.New("gas fee cap is required")
+)
+
+// DynamicFeeBuilder constructs a TxInternalDataEthereumDynamicFee with chainable setters.
+// The chain ID, the gas limit, the gas tip cap and the gas fee cap are required.
+// The nonce and the value default to zero, and a transaction without a recipient creates a contract.
+type DynamicFeeBuilder struct {
+	tx       *TxInternalDataEthereumDynamicFee
+	clampTip bool
+}
+
+// NewDynamicFeeBuilder returns a builder of an empty dynamic fee transaction.
+func NewDynamicFeeBuilder() *DynamicFeeBuilder {
+	tx := newTxInternalDataEthereumDynamicFee()
+	tx.ChainID, tx.GasTipCap, tx.GasFeeCap = nil, nil, nil
+	return &DynamicFeeBuilder{tx: tx}
+}
+
+func (b *DynamicFeeBuilder) ChainID(chainID *big.Int) *DynamicFeeBuilder {
+	b.tx.ChainID = copyBigInt(chainID)
+	return b
+}
+
+func (b *DynamicFeeBuilder) Nonce(nonce uint64) *DynamicFeeBuilder {
+	b.tx.AccountNonce = nonce
+	return b
+}
+
+func (b *DynamicFeeBuilder) To(to common.Address) *DynamicFeeBuilder {
+	b.tx.Recipient = &to
+	return b
+}
+
+func (b *DynamicFeeBuilder) Value(value *big.Int) *DynamicFeeBuilder {
+	if value == nil {
+		value = new(big.Int)
+	}
+	b.tx.Amount = new(big.Int).Set(value)
+	return b
+}
+
+func (b *DynamicFeeBuilder) Tip(tip *big.Int) *DynamicFeeBuilder {
+	b.tx.GasTipCap = copyBigInt(tip)
+	return b
+}
+
+func (b *DynamicFeeBuilder) FeeCap(feeCap *big.Int) *DynamicFeeBuilder {
+	b.tx.GasFeeCap = copyBigInt(feeCap)
+	return b
+}
+
+func (b *DynamicFeeBuilder) GasLimit(gasLimit uint64) *DynamicFeeBuilder {
+	b.tx.GasLimit = gasLimit
+	return b
+}
+
+func (b *DynamicFeeBuilder) Data(data []byte) *DynamicFeeBuilder {
+	b.tx.Payload = common.CopyBytes(data)
+	if b.tx.Payload == nil {
+		b.tx.Payload = []byte{}
+	}
+	return b
+}
+
+// AccessListEntry appends the address and its storage keys to the access list.
+func (b *DynamicFeeBuilder) AccessListEntry(addr common.Address, storageKeys ...common.Hash) *DynamicFeeBuilder {
+	keys := make([]common.Hash, len(storageKeys))
+	copy(keys, storageKeys)
+	b.tx.AccessList = append(b.tx.AccessList, AccessTuple{Address: addr, StorageKeys: keys})
+	return b
+}
+
+// ClampTip lowers the gas tip cap to the gas fee cap at build time.
+// Without it, a gas tip cap higher than the gas fee cap is kept as is, which the tx pool rejects.
+func (b *DynamicFeeBuilder) ClampTip() *DynamicFeeBuilder {
+	b.clampTip = true
+	return b
+}
+
+// Build validates the required fields and returns the transaction.
+// The builder can be reused since the returned transaction does not share any value with it.
+func (b *DynamicFeeBuilder) Build() (*TxInternalDataEthereumDynamicFee, error) {
+	switch {
+	case b.tx.ChainID == nil:
+		return nil, errBuilderNoChainID
+	case b.tx.GasLimit == 0:
+		return nil, errBuilderNoGasLimit
+	case b.tx.GasTipCap == nil:
+		return nil, errBuilderNoTip
+	case b.tx.GasFeeCap == nil:
+		return nil, errBuilderNoFeeCap
+	}
+
+	tx := b.tx.copyUnsigned()
+	if b.clampTip && tx.GasTipCap.Cmp(tx.GasFeeCap) > 0 {
+		tx.GasTipCap = new(big.Int).Set(tx.GasFeeCap)
+	}
+	return tx, nil
+}
+
+func copyBigInt(v *big.Int) *big.Int {
+	if v == nil {
+		return nil
+	}
+	return new(big.Int).Set(v)
+}
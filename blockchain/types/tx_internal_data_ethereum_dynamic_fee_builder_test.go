@@ -0,0 +1,97 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicFeeBuilder_Build(t *testing.T) {
+	to := common.HexToAddress("0x1")
+	slot := common.HexToHash("0x2")
+
+	tx, err := NewDynamicFeeBuilder().
+		ChainID(big.NewInt(1)).
+		Nonce(3).
+		To(to).
+		Value(big.NewInt(10)).
+		Tip(big.NewInt(2)).
+		FeeCap(big.NewInt(30)).
+		GasLimit(25000).
+		Data([]byte{0x1, 0x2}).
+		AccessListEntry(to, slot).
+		Build()
+	assert.NoError(t, err)
+
+	expected := newTxInternalDataEthereumDynamicFeeWithValues(3, &to, big.NewInt(10), 25000, big.NewInt(2), big.NewInt(30),
+		[]byte{0x1, 0x2}, nil, big.NewInt(1))
+	expected.AccessList = AccessList{{Address: to, StorageKeys: []common.Hash{slot}}}
+	assert.True(t, expected.Equal(tx))
+
+	// the optional fields have their default values
+	tx, err = NewDynamicFeeBuilder().ChainID(big.NewInt(1)).Tip(big.NewInt(2)).FeeCap(big.NewInt(30)).GasLimit(53000).Build()
+	assert.NoError(t, err)
+	assert.Nil(t, tx.Recipient)
+	assert.Equal(t, uint64(0), tx.AccountNonce)
+	assert.Equal(t, big.NewInt(0), tx.Amount)
+}
+
+func TestDynamicFeeBuilder_MissingFields(t *testing.T) {
+	complete := func() *DynamicFeeBuilder {
+		return NewDynamicFeeBuilder().ChainID(big.NewInt(1)).Tip(big.NewInt(2)).FeeCap(big.NewInt(30)).GasLimit(21000)
+	}
+
+	testcases := []struct {
+		builder  *DynamicFeeBuilder
+		expected error
+	}{
+		{complete().ChainID(nil), errBuilderNoChainID},
+		{complete().GasLimit(0), errBuilderNoGasLimit},
+		{complete().Tip(nil), errBuilderNoTip},
+		{complete().FeeCap(nil), errBuilderNoFeeCap},
+	}
+	for _, tc := range testcases {
+		tx, err := tc.builder.Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, tc.expected, err)
+	}
+}
+
+func TestDynamicFeeBuilder_ClampTip(t *testing.T) {
+	builder := NewDynamicFeeBuilder().ChainID(big.NewInt(1)).Tip(big.NewInt(31)).FeeCap(big.NewInt(30)).GasLimit(21000)
+
+	// without the option, the tip is preserved
+	tx, err := builder.Build()
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(31), tx.GasTipCap)
+	assert.Equal(t, big.NewInt(30), tx.GasFeeCap)
+
+	// with the option, the tip is clamped to the fee cap
+	tx, err = builder.ClampTip().Build()
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(30), tx.GasTipCap)
+	assert.Equal(t, big.NewInt(30), tx.GasFeeCap)
+
+	// a tip below the fee cap is not changed
+	tx, err = builder.Tip(big.NewInt(2)).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), tx.GasTipCap)
+}
@@ -0,0 +1,1652 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/fork"
+	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxInternalDataEthereumDynamicFee_IntrinsicGasDetailed(t *testing.T) {
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{
+		IstanbulCompatibleBlock: big.NewInt(0),
+		ShanghaiCompatibleBlock: big.NewInt(0),
+	})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	to := common.HexToAddress("0x1234")
+	accessList := AccessList{{Address: accessAddr, StorageKeys: []common.Hash{{0}, {1}}}}
+
+	testcases := []struct {
+		name       string
+		recipient  *common.Address
+		payload    []byte
+		accessList AccessList
+	}{
+		{"empty", &to, nil, nil},
+		{"payload", &to, common.FromHex("0000a6bc"), nil},
+		{"access list", &to, common.FromHex("ff"), accessList},
+		{"contract creation", nil, common.FromHex("6080604052"), accessList},
+	}
+
+	for _, tc := range testcases {
+		tx := &TxInternalDataEthereumDynamicFee{
+			Recipient:  tc.recipient,
+			Payload:    tc.payload,
+			AccessList: tc.accessList,
+		}
+
+		breakdown, err := tx.IntrinsicGasDetailed(0)
+		assert.NoError(t, err, tc.name)
+
+		total, err := tx.IntrinsicGas(0)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, breakdown.Base+breakdown.Data+breakdown.AccessList+breakdown.Creation, total, tc.name)
+
+		expected, err := IntrinsicGas(tc.payload, tc.accessList, tc.recipient == nil, params.Rules{IsIstanbul: true, IsShanghai: true})
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, expected, total, tc.name)
+
+		assert.Equal(t, params.TxGas, breakdown.Base, tc.name)
+		assert.Equal(t, uint64(len(tc.payload))*params.TxDataGas, breakdown.Data, tc.name)
+		if tc.recipient == nil {
+			assert.Equal(t, params.TxGasContractCreation-params.TxGas+params.InitCodeWordGas, breakdown.Creation, tc.name)
+		} else {
+			assert.Zero(t, breakdown.Creation, tc.name)
+		}
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_EqualUnsigned(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+
+	for _, recipient := range []*common.Address{&testAddr, nil} {
+		newTx := func() *TxInternalDataEthereumDynamicFee {
+			return newTxInternalDataEthereumDynamicFeeWithValues(3, recipient, big.NewInt(10), 25000,
+				big.NewInt(1), big.NewInt(2), common.FromHex("5544"), AccessList{}, big.NewInt(1))
+		}
+
+		tx1, err := SignTx(NewTx(newTx()), signer, key1)
+		assert.NoError(t, err)
+		tx2, err := SignTx(NewTx(newTx()), signer, key2)
+		assert.NoError(t, err)
+
+		data1 := tx1.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+		data2 := tx2.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+		assert.False(t, data1.Equal(data2))
+		assert.True(t, data1.EqualUnsigned(data2))
+
+		data3 := newTx()
+		data3.AccountNonce++
+		assert.False(t, data1.EqualUnsigned(data3))
+	}
+
+	assert.False(t, (&dynamicFeeTx).EqualUnsigned(&accessListTx))
+}
+
+func TestTxInternalDataEthereumDynamicFee_WithBumpedFees(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(100), big.NewInt(1000), common.FromHex("5544"), AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	bumped, err := data.WithBumpedFees(10, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(110), bumped.GasTipCap)
+	assert.Equal(t, big.NewInt(1100), bumped.GasFeeCap)
+	assert.Equal(t, data.AccountNonce, bumped.AccountNonce)
+	assert.Equal(t, data.Payload, bumped.Payload)
+	assert.Zero(t, bumped.V.Sign())
+	assert.Zero(t, bumped.R.Sign())
+	assert.Zero(t, bumped.S.Sign())
+	assert.Nil(t, bumped.Hash)
+
+	// The original transaction must not be modified.
+	assert.Equal(t, big.NewInt(100), data.GasTipCap)
+	assert.Equal(t, big.NewInt(1000), data.GasFeeCap)
+	assert.NotZero(t, data.R.Sign())
+
+	_, err = data.WithBumpedFees(0, 0)
+	assert.ErrorIs(t, err, errFeeBumpTooLow)
+
+	huge := data.copyUnsigned()
+	huge.GasFeeCap = new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+	_, err = huge.WithBumpedFees(10, 10)
+	assert.ErrorIs(t, err, errFeeBumpOverflow)
+}
+
+func TestTxInternalDataEthereumDynamicFee_CanReplace(t *testing.T) {
+	newTx := func(nonce uint64, tip, feeCap int64) *TxInternalDataEthereumDynamicFee {
+		return newTxInternalDataEthereumDynamicFeeWithValues(nonce, &testAddr, big.NewInt(10), 25000,
+			big.NewInt(tip), big.NewInt(feeCap), nil, nil, big.NewInt(1))
+	}
+	old := newTx(3, 100, 200)
+
+	// exactly at the bump
+	assert.True(t, newTx(3, 110, 220).CanReplace(old, 10))
+	assert.True(t, newTx(3, 150, 300).CanReplace(old, 10))
+
+	// below the bump
+	assert.False(t, newTx(3, 109, 220).CanReplace(old, 10))
+	assert.False(t, newTx(3, 110, 219).CanReplace(old, 10))
+	assert.False(t, newTx(3, 100, 200).CanReplace(old, 0))
+
+	// different nonce
+	assert.False(t, newTx(4, 110, 220).CanReplace(old, 10))
+}
+
+func TestTxInternalDataEthereumDynamicFee_PredictCreate2Address(t *testing.T) {
+	// Test vectors from EIP-1014.
+	testcases := []struct {
+		from     string
+		salt     string
+		initCode string
+		expected string
+	}{
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x00", "0x00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "0x00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+		{"0x00000000000000000000000000000000deadbeef", "0x00000000000000000000000000000000000000000000000000000000cafebabe", "0xdeadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+	}
+
+	tx := &TxInternalDataEthereumDynamicFee{}
+	for _, tc := range testcases {
+		from := common.HexToAddress(tc.from)
+		salt := common.HexToHash(tc.salt)
+		initCodeHash := crypto.Keccak256Hash(common.FromHex(tc.initCode))
+
+		addr := tx.PredictCreate2Address(from, salt, initCodeHash)
+		assert.Equal(t, common.HexToAddress(tc.expected), addr)
+		assert.Equal(t, crypto.CreateAddress2(from, salt, initCodeHash.Bytes()), addr)
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_ValidateContractAddress(t *testing.T) {
+	from := common.HexToAddress("0xdeadbeef")
+	creation := newTxInternalDataEthereumDynamicFeeWithValues(3, nil, big.NewInt(0), 100000,
+		big.NewInt(1), big.NewInt(1), []byte{0x60}, AccessList{}, big.NewInt(1))
+	call := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(0), 100000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))
+	assert.NoError(t, creation.ValidateContractAddress(from))
+
+	// force the creation address of the sender and the nonce to collide with a precompiled contract
+	defer func(orig func(common.Address, uint64) common.Address) { createAddress = orig }(createAddress)
+	createAddress = func(b common.Address, nonce uint64) common.Address {
+		if b == from && nonce == 3 {
+			return common.HexToAddress("0x3")
+		}
+		return crypto.CreateAddress(b, nonce)
+	}
+	assert.Equal(t, kerrors.ErrPrecompiledContractAddress, creation.ValidateContractAddress(from))
+	assert.NoError(t, creation.ValidateContractAddress(common.HexToAddress("0xcafe")))
+	assert.NoError(t, call.ValidateContractAddress(from))
+
+	// the check is not a part of Validate
+	assert.NotEqual(t, kerrors.ErrPrecompiledContractAddress, creation.Validate(nil, 0))
+}
+
+func TestTxInternalDataEthereumDynamicFee_IsSignatureMalleable(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	// the signer produces a low-s signature
+	assert.False(t, data.IsSignatureMalleable())
+
+	// the equivalent high-s signature
+	n := crypto.S256().Params().N
+	data.S = new(big.Int).Sub(n, data.S)
+	data.V = new(big.Int).Xor(data.V, common.Big1)
+	assert.True(t, data.IsSignatureMalleable())
+
+	// the boundary is the half of the curve order
+	data.S = new(big.Int).Rsh(n, 1)
+	assert.False(t, data.IsSignatureMalleable())
+	data.S.Add(data.S, common.Big1)
+	assert.True(t, data.IsSignatureMalleable())
+}
+
+func TestTxInternalDataEthereumDynamicFee_NormalizeSignature(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+	lowV, lowS := new(big.Int).Set(data.V), new(big.Int).Set(data.S)
+
+	// a low-s signature is unchanged
+	data.NormalizeSignature()
+	assert.Equal(t, lowV, data.V)
+	assert.Equal(t, lowS, data.S)
+
+	// a high-s signature is normalized into the low-s one recovering the same sender
+	data.S = new(big.Int).Sub(crypto.S256().Params().N, data.S)
+	data.V = new(big.Int).Xor(data.V, common.Big1)
+	assert.True(t, data.IsSignatureMalleable())
+
+	hash := data.TxHash()
+	data.SetHash(&hash)
+	data.NormalizeSignature()
+	assert.Nil(t, data.GetHash())
+	assert.False(t, data.IsSignatureMalleable())
+	assert.Equal(t, 0, lowV.Cmp(data.V))
+	assert.Equal(t, 0, lowS.Cmp(data.S))
+	assert.NoError(t, data.VerifySender(signer, from))
+}
+
+func TestTxInternalDataEthereumDynamicFee_CalldataGas(t *testing.T) {
+	istanbulBlock := uint64(10)
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{
+		IstanbulCompatibleBlock: new(big.Int).SetUint64(istanbulBlock),
+	})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	// 2 zero bytes and 3 non-zero bytes
+	payload := []byte{0x0, 0x1, 0x0, 0x2, 0x3}
+	tx := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, 100000, nil, nil, payload,
+		AccessList{{Address: testAddr, StorageKeys: []common.Hash{{0x1}}}}, big.NewInt(1))
+
+	// zero and non-zero bytes are priced differently before Istanbul
+	assert.Equal(t, 2*params.TxDataZeroGas+3*params.TxDataNonZeroGas, tx.CalldataGas(istanbulBlock-1))
+	// every byte is priced the same since Istanbul
+	assert.Equal(t, 5*params.TxDataGas, tx.CalldataGas(istanbulBlock))
+
+	// it is the payload part of the intrinsic gas
+	for _, blockNumber := range []uint64{istanbulBlock - 1, istanbulBlock} {
+		breakdown, err := tx.IntrinsicGasDetailed(blockNumber)
+		assert.NoError(t, err)
+		assert.Equal(t, breakdown.Data, tx.CalldataGas(blockNumber))
+	}
+
+	tx.Payload = nil
+	assert.Zero(t, tx.CalldataGas(istanbulBlock))
+}
+
+func TestTxInternalDataEthereumDynamicFee_IntrinsicGasExceedsLimit(t *testing.T) {
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	tx := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, 0, nil, nil, []byte{0x1, 0x2},
+		AccessList{{Address: testAddr, StorageKeys: []common.Hash{{0x1}}}}, big.NewInt(1))
+	intrinsic, err := tx.IntrinsicGas(0)
+	assert.NoError(t, err)
+
+	tx.GasLimit = intrinsic - 1
+	exceeds, err := tx.IntrinsicGasExceedsLimit(0)
+	assert.NoError(t, err)
+	assert.True(t, exceeds)
+
+	for _, gasLimit := range []uint64{intrinsic, intrinsic + 1} {
+		tx.GasLimit = gasLimit
+		exceeds, err = tx.IntrinsicGasExceedsLimit(0)
+		assert.NoError(t, err)
+		assert.False(t, exceeds)
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_VerifyIntrinsicGas(t *testing.T) {
+	istanbulBlock := uint64(10)
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{
+		IstanbulCompatibleBlock: new(big.Int).SetUint64(istanbulBlock),
+	})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	tx := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, 100000, nil, nil, []byte{0x0, 0x1},
+		AccessList{{Address: testAddr, StorageKeys: []common.Hash{{0x1}}}}, big.NewInt(1))
+
+	for _, blockNumber := range []uint64{istanbulBlock - 1, istanbulBlock} {
+		gas, err := tx.IntrinsicGas(blockNumber)
+		assert.NoError(t, err)
+
+		assert.NoError(t, tx.VerifyIntrinsicGas(gas, blockNumber))
+		assert.ErrorIs(t, tx.VerifyIntrinsicGas(gas+1, blockNumber), ErrIntrinsicGasMismatch)
+		assert.ErrorIs(t, tx.VerifyIntrinsicGas(gas-1, blockNumber), ErrIntrinsicGasMismatch)
+	}
+
+	// the payload is priced differently before Istanbul, so a claim for the wrong block mismatches
+	before, err := tx.IntrinsicGas(istanbulBlock - 1)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, tx.VerifyIntrinsicGas(before, istanbulBlock), ErrIntrinsicGasMismatch)
+}
+
+func TestTxInternalDataEthereumDynamicFee_MaxTxsInBlock(t *testing.T) {
+	tx := &TxInternalDataEthereumDynamicFee{GasLimit: 21000}
+
+	testcases := []struct {
+		blockGasLimit uint64
+		expected      uint64
+	}{
+		{210000, 10}, // exact division
+		{219999, 10}, // the remainder is not enough for another transaction
+		{21000, 1},   // exactly one
+		{20999, 1},   // at least one
+		{0, 1},       // at least one
+		{math.MaxUint64, math.MaxUint64 / 21000},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, tx.MaxTxsInBlock(tc.blockGasLimit), "blockGasLimit=%d", tc.blockGasLimit)
+	}
+
+	tx.GasLimit = 0
+	assert.Equal(t, uint64(math.MaxUint64), tx.MaxTxsInBlock(210000))
+}
+
+func TestTxInternalDataEthereumDynamicFee_ValidateHighS(t *testing.T) {
+	cancunBlock := uint64(10)
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{
+		CancunCompatibleBlock: new(big.Int).SetUint64(cancunBlock),
+	})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	// A low-s signature is valid before and after the Cancun fork.
+	for _, blockNumber := range []uint64{0, cancunBlock - 1, cancunBlock} {
+		assert.NoError(t, data.Validate(nil, blockNumber))
+	}
+	sender, err := Sender(signer, NewTx(data))
+	assert.NoError(t, err)
+	assert.Equal(t, from, sender)
+
+	// Convert the signature into the equivalent high-s form.
+	n := crypto.S256().Params().N
+	data.S = new(big.Int).Sub(n, data.S)
+	data.V = new(big.Int).Xor(data.V, common.Big1)
+
+	// It can be decoded, but is rejected at any block as the signer rejects it.
+	assert.True(t, data.ValidateSignature())
+	assert.False(t, data.ValidateLowSSignature())
+	for _, blockNumber := range []uint64{0, cancunBlock - 1, cancunBlock} {
+		assert.ErrorIs(t, data.Validate(nil, blockNumber), ErrInvalidSig)
+	}
+	_, err = Sender(signer, NewTx(data))
+	assert.ErrorIs(t, err, ErrInvalidSig)
+}
+
+func genDynamicFeeTxBatch() []*TxInternalDataEthereumDynamicFee {
+	to := common.HexToAddress("0x1234")
+	largeAccessList := AccessList{
+		{Address: accessAddr, StorageKeys: []common.Hash{{0}, {1}, {2}}},
+		{Address: to, StorageKeys: []common.Hash{}},
+		{Address: testAddr, StorageKeys: []common.Hash{{3}}},
+	}
+	smallAccessList := AccessList{{Address: to, StorageKeys: []common.Hash{{4}}}}
+
+	return []*TxInternalDataEthereumDynamicFee{
+		{ChainID: big.NewInt(1), AccountNonce: 0, GasTipCap: big.NewInt(0), GasFeeCap: big.NewInt(0), Recipient: &to, Amount: big.NewInt(0), Payload: []byte{}, AccessList: AccessList{}, V: big.NewInt(0), R: big.NewInt(0), S: big.NewInt(0)},
+		{ChainID: big.NewInt(8217), AccountNonce: 1, GasTipCap: big.NewInt(25e9), GasFeeCap: big.NewInt(750e9), GasLimit: 21000, Recipient: &testAddr, Amount: big.NewInt(1e18), Payload: []byte{0x01}, AccessList: largeAccessList, V: big.NewInt(1), R: big.NewInt(0x7f), S: big.NewInt(0x80)},
+		{ChainID: big.NewInt(1001), AccountNonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), GasLimit: 1e6, Recipient: nil, Amount: big.NewInt(3), Payload: common.FromHex("6080604052348015600f57600080fd5b50"), AccessList: smallAccessList, V: big.NewInt(0), R: new(big.Int).Lsh(common.Big1, 255), S: big.NewInt(12345)},
+		{ChainID: big.NewInt(1), AccountNonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(1), GasLimit: 25000, Recipient: &to, Amount: big.NewInt(10), Payload: common.FromHex("5544"), AccessList: AccessList{}, V: big.NewInt(1), R: big.NewInt(1), S: big.NewInt(1)},
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_DecodeFromStream(t *testing.T) {
+	decoded := newEmptyTxInternalDataEthereumDynamicFee()
+
+	for i, tx := range genDynamicFeeTxBatch() {
+		enc, err := rlp.EncodeToBytes(tx)
+		assert.NoError(t, err)
+
+		err = decoded.DecodeFromStream(rlp.NewStream(bytes.NewReader(enc), uint64(len(enc))))
+		assert.NoError(t, err, i)
+
+		assert.True(t, tx.Equal(decoded), i)
+		assert.Equal(t, tx.Payload, decoded.Payload, i)
+
+		reenc, err := rlp.EncodeToBytes(decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, enc, reenc, i)
+	}
+
+	// Trailing elements must be rejected.
+	enc, _ := rlp.EncodeToBytes([]interface{}{
+		big.NewInt(1), uint64(0), big.NewInt(1), big.NewInt(1), uint64(0), []byte{}, big.NewInt(0), []byte{}, AccessList{},
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), uint64(1),
+	})
+	assert.Error(t, decoded.DecodeFromStream(rlp.NewStream(bytes.NewReader(enc), uint64(len(enc)))))
+}
+
+// assertRoundtrip checks that the transaction is decoded back to itself through every decoding path.
+// decoded is reused by DecodeFromStream, so it has the fields of the previously decoded transaction.
+func assertRoundtrip(t *testing.T, tx *Transaction, decoded *TxInternalDataEthereumDynamicFee) {
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+	check := func(got *TxInternalDataEthereumDynamicFee, path string) {
+		assert.True(t, data.Equal(got), path)
+		assert.Equal(t, data.GetRecipient(), got.GetRecipient(), path)
+		assert.Equal(t, data.IsContractCreation(), got.IsContractCreation(), path)
+	}
+
+	enc, err := tx.MarshalBinary()
+	assert.NoError(t, err)
+	var decodedTx Transaction
+	assert.NoError(t, decodedTx.UnmarshalBinary(enc))
+	check(decodedTx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee), "binary")
+
+	js, err := tx.MarshalJSON()
+	assert.NoError(t, err)
+	decodedTx = Transaction{}
+	assert.NoError(t, decodedTx.UnmarshalJSON(js))
+	check(decodedTx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee), "json")
+
+	raw, err := rlp.EncodeToBytes(data)
+	assert.NoError(t, err)
+	got, _, err := DecodeDynamicFeeRawTx(append([]byte{byte(data.Type())}, raw...))
+	assert.NoError(t, err)
+	check(got, "raw")
+
+	batch, err := EncodeDynamicFeeBatch([]*TxInternalDataEthereumDynamicFee{data})
+	assert.NoError(t, err)
+	txs, err := DecodeDynamicFeeBatch(batch)
+	assert.NoError(t, err)
+	check(txs[0], "batch")
+
+	assert.NoError(t, decoded.DecodeFromStream(rlp.NewStream(bytes.NewReader(raw), uint64(len(raw)))))
+	check(decoded, "stream")
+}
+
+func TestTxInternalDataEthereumDynamicFee_RoundtripNilRecipient(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	decoded := newEmptyTxInternalDataEthereumDynamicFee()
+
+	zero := common.Address{}
+	// the contract creation is decoded after the others to check that the recipient is not reused
+	for _, to := range []*common.Address{&testAddr, &zero, nil, &testAddr} {
+		tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(0, to, big.NewInt(1), 100000,
+			big.NewInt(1), big.NewInt(1), []byte{0x60, 0x00}, AccessList{}, big.NewInt(1))), signer, key)
+		assert.NoError(t, err)
+		assertRoundtrip(t, tx, decoded)
+		if to == nil {
+			assert.Nil(t, decoded.GetRecipient())
+		}
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_DecodeFromStreamShared(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0x1234")
+
+	// the signed transaction holds the chain ID of the signer and the recipient given to the constructor
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &to, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	decoded := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	other := genDynamicFeeTxBatch()[1]
+	enc, err := rlp.EncodeToBytes(other)
+	assert.NoError(t, err)
+	assert.NoError(t, decoded.DecodeFromStream(rlp.NewStream(bytes.NewReader(enc), uint64(len(enc)))))
+	assert.True(t, other.Equal(decoded))
+
+	assert.Equal(t, big.NewInt(1), signer.ChainID())
+	assert.Equal(t, common.HexToAddress("0x1234"), to)
+}
+
+func TestTxInternalDataEthereumDynamicFee_DecodeNonCanonicalInteger(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(30), nil, AccessList{}, big.NewInt(1))), LatestSignerForChainID(big.NewInt(1)), key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	fields := func() []interface{} {
+		return []interface{}{
+			data.ChainID, data.AccountNonce, data.GasTipCap, data.GasFeeCap, data.GasLimit, data.Recipient,
+			data.Amount, data.Payload, data.AccessList, data.V, data.R, data.S,
+		}
+	}
+	// the canonical encoding is decoded
+	enc, err := rlp.EncodeToBytes(fields())
+	assert.NoError(t, err)
+	assert.NoError(t, rlp.DecodeBytes(enc, newEmptyTxInternalDataEthereumDynamicFee()))
+
+	// an integer field encoded with a leading zero byte, which has the same value
+	for i, nonCanonical := range map[int][]byte{
+		0:  {0x0, 0x1},                             // ChainID
+		1:  {0x0, 0x3},                             // AccountNonce
+		3:  {0x0, 0x1e},                            // GasFeeCap
+		6:  {0x0, 0xa},                             // Amount
+		11: append([]byte{0x0}, data.S.Bytes()...), // S
+	} {
+		elems := fields()
+		elems[i] = nonCanonical
+		enc, err := rlp.EncodeToBytes(elems)
+		assert.NoError(t, err)
+
+		err = rlp.DecodeBytes(enc, newEmptyTxInternalDataEthereumDynamicFee())
+		assert.ErrorContains(t, err, "non-canonical integer", "field %d", i)
+		err = newEmptyTxInternalDataEthereumDynamicFee().DecodeFromStream(rlp.NewStream(bytes.NewReader(enc), uint64(len(enc))))
+		assert.ErrorIs(t, err, rlp.ErrCanonInt, "field %d", i)
+		_, _, err = DecodeDynamicFeeRawTx(append([]byte{byte(data.Type())}, enc...))
+		assert.ErrorContains(t, err, "non-canonical integer", "field %d", i)
+		err = new(Transaction).UnmarshalBinary(append([]byte{byte(EthereumTxTypeEnvelope), byte(data.Type())}, enc...))
+		assert.ErrorContains(t, err, "non-canonical integer", "field %d", i)
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_EqualBytes(t *testing.T) {
+	newTx := func(payload []byte) *TxInternalDataEthereumDynamicFee {
+		txdata := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+			big.NewInt(1), big.NewInt(30), payload, nil, big.NewInt(1))
+		txdata.AccessList = AccessList{{Address: testAddr, StorageKeys: []common.Hash{{0x1}}}}
+		return txdata
+	}
+
+	assert.True(t, newTx([]byte{0x1}).EqualBytes(newTx([]byte{0x1})))
+	assert.False(t, newTx([]byte{0x1}).EqualBytes(newTx([]byte{0x2})))
+	assert.False(t, newTx(nil).EqualBytes(newTxInternalDataLegacy()))
+
+	tx := newTx(nil)
+	tx.AccessList[0].StorageKeys[0] = common.Hash{0x2}
+	assert.False(t, tx.EqualBytes(newTx(nil)))
+}
+
+func BenchmarkTxInternalDataEthereumDynamicFee_Equal(b *testing.B) {
+	newTx := func() *TxInternalDataEthereumDynamicFee {
+		txdata := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+			big.NewInt(1), big.NewInt(30), nil, nil, big.NewInt(1))
+		for i := 0; i < 1000; i++ {
+			keys := make([]common.Hash, 10)
+			for j := range keys {
+				keys[j] = common.BigToHash(big.NewInt(int64(i*10 + j)))
+			}
+			txdata.AccessList = append(txdata.AccessList, AccessTuple{Address: common.BigToAddress(big.NewInt(int64(i))), StorageKeys: keys})
+		}
+		return txdata
+	}
+	tx1, tx2 := newTx(), newTx()
+
+	b.Run("Equal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if !tx1.Equal(tx2) {
+				b.Fatal("not equal")
+			}
+		}
+	})
+
+	b.Run("EqualBytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if !tx1.EqualBytes(tx2) {
+				b.Fatal("not equal")
+			}
+		}
+	})
+}
+
+func BenchmarkTxInternalDataEthereumDynamicFee_Decode(b *testing.B) {
+	var encs [][]byte
+	for _, tx := range genDynamicFeeTxBatch() {
+		enc, _ := rlp.EncodeToBytes(tx)
+		encs = append(encs, enc)
+	}
+
+	b.Run("Decode", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, enc := range encs {
+				decoded := newEmptyTxInternalDataEthereumDynamicFee()
+				if err := rlp.DecodeBytes(enc, decoded); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("DecodeFromStream", func(b *testing.B) {
+		b.ReportAllocs()
+		decoded := newEmptyTxInternalDataEthereumDynamicFee()
+		reader := bytes.NewReader(nil)
+		stream := rlp.NewStream(reader, 0)
+		for i := 0; i < b.N; i++ {
+			for _, enc := range encs {
+				reader.Reset(enc)
+				stream.Reset(reader, uint64(len(enc)))
+				if err := decoded.DecodeFromStream(stream); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
+
+func TestTxInternalDataEthereumDynamicFee_IsIncludable(t *testing.T) {
+	tx := &TxInternalDataEthereumDynamicFee{GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(10)}
+
+	assert.True(t, tx.IsIncludable(nil))
+	assert.True(t, tx.IsIncludable(big.NewInt(0)))
+	assert.True(t, tx.IsIncludable(big.NewInt(99)))
+	assert.True(t, tx.IsIncludable(big.NewInt(100)))
+	assert.False(t, tx.IsIncludable(big.NewInt(101)))
+}
+
+func TestTxInternalDataEthereumDynamicFee_MinTipForInclusion(t *testing.T) {
+	tx := &TxInternalDataEthereumDynamicFee{GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(10)}
+
+	rising := []*big.Int{big.NewInt(50), big.NewInt(60), big.NewInt(75)}
+	assert.Equal(t, big.NewInt(25), tx.MinTipForInclusion(rising))
+
+	falling := []*big.Int{big.NewInt(90), big.NewInt(70), big.NewInt(40)}
+	assert.Equal(t, big.NewInt(10), tx.MinTipForInclusion(falling))
+
+	// no positive tip is left if the fee cap does not exceed the highest base fee
+	assert.Nil(t, tx.MinTipForInclusion([]*big.Int{big.NewInt(80), big.NewInt(100)}))
+	assert.Nil(t, tx.MinTipForInclusion([]*big.Int{big.NewInt(120), big.NewInt(80)}))
+
+	// the whole fee cap is left without a forecast
+	assert.Equal(t, big.NewInt(100), tx.MinTipForInclusion(nil))
+	assert.Equal(t, big.NewInt(100), tx.MinTipForInclusion([]*big.Int{nil}))
+}
+
+func TestTxInternalDataEthereumDynamicFee_FeeRange(t *testing.T) {
+	tx := &TxInternalDataEthereumDynamicFee{GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(10), GasLimit: 21000}
+
+	// base fee below the cap
+	min, max := tx.FeeRange(big.NewInt(25))
+	assert.Equal(t, big.NewInt(25*21000), min)
+	assert.Equal(t, big.NewInt(100*21000), max)
+
+	// base fee above the cap
+	min, max = tx.FeeRange(big.NewInt(101))
+	assert.Equal(t, big.NewInt(100*21000), min)
+	assert.Equal(t, big.NewInt(100*21000), max)
+
+	// before magma
+	min, max = tx.FeeRange(nil)
+	assert.Equal(t, big.NewInt(100*21000), min)
+	assert.Equal(t, big.NewInt(100*21000), max)
+}
+
+func TestTxInternalDataEthereumDynamicFee_MakeRPCOutputWithFrom(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	output, err := data.MakeRPCOutputWithFrom(signer)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), output["from"])
+
+	_, ok := data.MakeRPCOutput()["from"]
+	assert.False(t, ok)
+
+	// recovery fails with an invalid signature
+	data.R = big.NewInt(0)
+	_, err = data.MakeRPCOutputWithFrom(signer)
+	assert.Error(t, err)
+}
+
+func TestTxInternalDataEthereumDynamicFee_MakeLightRPCOutput(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	for _, to := range []*common.Address{&testAddr, nil} {
+		tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, to, big.NewInt(10), 25000,
+			big.NewInt(1), big.NewInt(1), []byte{0x1}, AccessList{}, big.NewInt(1))), signer, key)
+		assert.NoError(t, err)
+		data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+		// the API adds the hash to the full output
+		full := data.MakeRPCOutput()
+		full["hash"] = tx.Hash()
+
+		light := data.MakeLightRPCOutput()
+		assert.Len(t, light, 5)
+		assert.Less(t, len(light), len(full))
+		for key, value := range light {
+			assert.Contains(t, full, key)
+			assert.Equal(t, full[key], value, key)
+		}
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_SemanticFingerprint(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	newTx := func(to *common.Address, amount *big.Int, gasLimit uint64, gasTipCap, gasFeeCap *big.Int) *TxInternalDataEthereumDynamicFee {
+		tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, to, amount, gasLimit,
+			gasTipCap, gasFeeCap, []byte{0x1}, AccessList{}, big.NewInt(1))), signer, key)
+		assert.NoError(t, err)
+		return tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+	}
+
+	tx := newTx(&testAddr, big.NewInt(10), 25000, big.NewInt(1), big.NewInt(1))
+	replacement := newTx(&testAddr, big.NewInt(10), 30000, big.NewInt(2), big.NewInt(3))
+	assert.NotEqual(t, tx.SenderTxHash(), replacement.SenderTxHash())
+	assert.Equal(t, tx.SemanticFingerprint(from), replacement.SemanticFingerprint(from))
+
+	// the sender, recipient and value are part of the fingerprint
+	assert.NotEqual(t, tx.SemanticFingerprint(from), tx.SemanticFingerprint(testAddr))
+	assert.NotEqual(t, tx.SemanticFingerprint(from), newTx(nil, big.NewInt(10), 25000, big.NewInt(1), big.NewInt(1)).SemanticFingerprint(from))
+	assert.NotEqual(t, tx.SemanticFingerprint(from), newTx(&testAddr, big.NewInt(11), 25000, big.NewInt(1), big.NewInt(1)).SemanticFingerprint(from))
+}
+
+func TestTxInternalDataEthereumDynamicFee_VerifySender(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	// matching sender
+	assert.NoError(t, data.VerifySender(signer, crypto.PubkeyToAddress(key.PublicKey)))
+
+	// mismatching sender
+	err = data.VerifySender(signer, testAddr)
+	assert.ErrorIs(t, err, ErrSenderMismatch)
+
+	// recovery fails with an invalid signature
+	data.R = big.NewInt(0)
+	err = data.VerifySender(signer, crypto.PubkeyToAddress(key.PublicKey))
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrSenderMismatch)
+}
+
+func TestTxInternalDataEthereumDynamicFee_PriorityScore(t *testing.T) {
+	data := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, 21000, big.NewInt(10), big.NewInt(100), nil, nil, big.NewInt(1))
+	tx := NewTx(data)
+
+	testcases := []struct {
+		baseFee  *big.Int
+		expected *big.Int
+	}{
+		{big.NewInt(50), big.NewInt(10)}, // the fee cap is well above the base fee, so the whole tip
+		{big.NewInt(95), big.NewInt(5)},  // the fee cap is slightly above the base fee
+		{big.NewInt(100), big.NewInt(0)}, // at the base fee
+		{big.NewInt(120), big.NewInt(0)}, // below the base fee, clamped at zero
+		{nil, big.NewInt(10)},            // no base fee
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, data.PriorityScore(tc.baseFee), "baseFee=%v", tc.baseFee)
+		// it equals EffectiveGasTip unless the fee cap is below the base fee
+		if tc.baseFee != nil && tc.baseFee.Cmp(data.GasFeeCap) <= 0 {
+			assert.Zero(t, tx.EffectiveGasTip(tc.baseFee).Cmp(data.PriorityScore(tc.baseFee)))
+		}
+	}
+	// EffectiveGasTip is negative below the base fee
+	assert.Equal(t, big.NewInt(-20), tx.EffectiveGasTip(big.NewInt(120)))
+}
+
+func TestTxInternalDataEthereumDynamicFee_FeeRegime(t *testing.T) {
+	data := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, 21000, big.NewInt(10), big.NewInt(100), nil, nil, big.NewInt(1))
+
+	testcases := []struct {
+		baseFee  *big.Int
+		expected string
+	}{
+		{big.NewInt(50), FeeRegimeTipSatisfied}, // the fee cap is well above the base fee
+		{big.NewInt(90), FeeRegimeTipSatisfied}, // exactly the whole tip is left above the base fee
+		{big.NewInt(95), FeeRegimeTipCapped},    // only a part of the tip is left above the base fee
+		{big.NewInt(100), FeeRegimeTipCapped},   // at the base fee
+		{big.NewInt(120), FeeRegimeUnderpriced}, // below the base fee
+		{nil, FeeRegimeTipSatisfied},            // no base fee
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, data.FeeRegime(tc.baseFee), "baseFee=%v", tc.baseFee)
+	}
+}
+
+// nonceStateDB is a StateDB which only implements GetNonce.
+type nonceStateDB struct {
+	StateDB
+	nonces map[common.Address]uint64
+}
+
+func (s *nonceStateDB) GetNonce(addr common.Address) uint64 {
+	return s.nonces[addr]
+}
+
+func TestTxInternalDataEthereumDynamicFee_IsNonceStale(t *testing.T) {
+	from := common.HexToAddress("0xbeef")
+	stateDB := &nonceStateDB{nonces: map[common.Address]uint64{from: 5}}
+
+	testcases := []struct {
+		nonce    uint64
+		expected bool
+	}{
+		{4, true},  // below the account nonce
+		{5, false}, // at the account nonce
+		{6, false}, // above the account nonce, which is a future transaction
+	}
+	for _, tc := range testcases {
+		data := newTxInternalDataEthereumDynamicFeeWithValues(tc.nonce, &testAddr, nil, 21000, big.NewInt(1), big.NewInt(1), nil, nil, big.NewInt(1))
+		assert.Equal(t, tc.expected, data.IsNonceStale(stateDB, from), "nonce=%d", tc.nonce)
+	}
+
+	// a new account has the nonce 0
+	data := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, 21000, big.NewInt(1), big.NewInt(1), nil, nil, big.NewInt(1))
+	assert.False(t, data.IsNonceStale(stateDB, testAddr))
+}
+
+func TestTxInternalDataEthereumDynamicFee_FeeContribution(t *testing.T) {
+	data := newTxInternalDataEthereumDynamicFeeWithValues(0, &testAddr, nil, params.UpperGasLimit, big.NewInt(10), big.NewInt(25), nil, nil, big.NewInt(1))
+	usage := NewTxFeeUsage(data, &Receipt{GasUsed: params.UpperGasLimit / 4})
+
+	testcases := []struct {
+		baseFee  *big.Int
+		expected *big.Int
+	}{
+		{big.NewInt(0), big.NewInt(0)},   // the tip is not paid
+		{big.NewInt(15), big.NewInt(15)}, // regardless of the room left for the tip
+		{big.NewInt(25), big.NewInt(25)}, // at the gas fee cap
+		{nil, big.NewInt(25)},            // the gas fee cap, which is the unit price before Magma
+	}
+	for _, tc := range testcases {
+		reward, gasUsedRatio := usage.FeeContribution(tc.baseFee)
+		assert.Equal(t, tc.expected, reward, "baseFee=%v", tc.baseFee)
+		// the ratio follows the gas used, not the gas limit
+		assert.InDelta(t, 0.25, gasUsedRatio, 1e-9)
+	}
+
+	// the reward is a copy
+	baseFee := big.NewInt(25)
+	reward, _ := usage.FeeContribution(baseFee)
+	reward.SetInt64(0)
+	assert.Equal(t, big.NewInt(25), baseFee)
+	reward, _ = usage.FeeContribution(nil)
+	reward.SetInt64(0)
+	assert.Equal(t, big.NewInt(25), data.GasFeeCap)
+}
+
+func TestTxInternalDataEthereumDynamicFee_HasEnoughBalance(t *testing.T) {
+	tx := &TxInternalDataEthereumDynamicFee{GasFeeCap: big.NewInt(100), GasLimit: 21000, Amount: big.NewInt(5)}
+	cost := big.NewInt(100*21000 + 5)
+
+	// exact balance
+	ok, shortfall := tx.HasEnoughBalance(cost, nil)
+	assert.True(t, ok)
+	assert.Zero(t, shortfall.Sign())
+
+	// the caller owns the returned shortfall
+	shortfall.SetInt64(1)
+	assert.Zero(t, common.Big0.Sign())
+	_, shortfall = tx.HasEnoughBalance(cost, nil)
+	assert.Zero(t, shortfall.Sign())
+
+	// one peb short
+	ok, shortfall = tx.HasEnoughBalance(new(big.Int).Sub(cost, common.Big1), big.NewInt(25))
+	assert.False(t, ok)
+	assert.Equal(t, common.Big1, shortfall)
+
+	// the base fee is charged if it is higher than the fee cap
+	ok, shortfall = tx.HasEnoughBalance(cost, big.NewInt(101))
+	assert.False(t, ok)
+	assert.Equal(t, big.NewInt(21000), shortfall)
+
+	// huge values must not overflow
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+	huge := &TxInternalDataEthereumDynamicFee{GasFeeCap: maxUint256, GasLimit: math.MaxUint64, Amount: maxUint256}
+	hugeCost := new(big.Int).Mul(maxUint256, new(big.Int).SetUint64(math.MaxUint64))
+	hugeCost.Add(hugeCost, maxUint256)
+
+	ok, shortfall = huge.HasEnoughBalance(maxUint256, nil)
+	assert.False(t, ok)
+	assert.Equal(t, new(big.Int).Sub(hugeCost, maxUint256), shortfall)
+
+	ok, _ = huge.HasEnoughBalance(hugeCost, nil)
+	assert.True(t, ok)
+}
+
+func TestTxInternalDataEthereumDynamicFee_AccessedAddresses(t *testing.T) {
+	from := common.HexToAddress("0xf")
+	to := common.HexToAddress("0x1234")
+	other := common.HexToAddress("0x5678")
+
+	tx := &TxInternalDataEthereumDynamicFee{
+		Recipient: &to,
+		AccessList: AccessList{
+			{Address: to, StorageKeys: []common.Hash{{0}}},
+			{Address: from},
+			{Address: other},
+			{Address: other, StorageKeys: []common.Hash{{1}}},
+		},
+	}
+	assert.Equal(t, []common.Address{from, to, other}, tx.AccessedAddresses(from))
+
+	// contract creation does not include a recipient
+	tx.Recipient = nil
+	tx.AccessList = AccessList{{Address: other}}
+	assert.Equal(t, []common.Address{from, other}, tx.AccessedAddresses(from))
+}
+
+func TestTxInternalDataEthereumDynamicFee_Signature(t *testing.T) {
+	tx := newTxInternalDataEthereumDynamicFee()
+	tx.SetSignature(TxSignatures{&TxSignature{big.NewInt(1), big.NewInt(2), big.NewInt(3)}})
+
+	assert.Equal(t, tx.RawSignatureValues()[0], tx.Signature())
+}
+
+func TestTxInternalDataEthereumDynamicFee_AnnounceSummary(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(7, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(2), big.NewInt(30), []byte{0x1, 0x2, 0x3}, nil, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	summary := data.AnnounceSummary()
+	assert.Equal(t, tx.Hash(), summary.Hash)
+	assert.Equal(t, tx.Nonce(), summary.Nonce)
+	assert.Equal(t, tx.GasFeeCap(), summary.GasFeeCap)
+	assert.Equal(t, tx.GasTipCap(), summary.GasTipCap)
+	assert.Equal(t, uint64(tx.Size()), summary.Size)
+
+	// the summary does not share the fees with the transaction
+	summary.GasFeeCap.SetInt64(0)
+	assert.Equal(t, big.NewInt(30), data.GasFeeCap)
+
+	// the summary can be gossiped in RLP
+	enc, err := rlp.EncodeToBytes(summary)
+	assert.NoError(t, err)
+	var dec TxAnnouncement
+	assert.NoError(t, rlp.DecodeBytes(enc, &dec))
+	assert.Equal(t, tx.Hash(), dec.Hash)
+}
+
+func TestTxInternalDataEthereumDynamicFee_NegativeAmount(t *testing.T) {
+	values := func(amount *big.Int) map[TxValueKeyType]interface{} {
+		return map[TxValueKeyType]interface{}{
+			TxValueKeyNonce:      uint64(0),
+			TxValueKeyTo:         &testAddr,
+			TxValueKeyAmount:     amount,
+			TxValueKeyGasLimit:   uint64(21000),
+			TxValueKeyGasFeeCap:  big.NewInt(30),
+			TxValueKeyGasTipCap:  big.NewInt(2),
+			TxValueKeyData:       []byte{},
+			TxValueKeyAccessList: AccessList{},
+			TxValueKeyChainID:    big.NewInt(1),
+		}
+	}
+
+	tx, err := NewTxInternalDataWithMap(TxTypeEthereumDynamicFee, values(big.NewInt(-1)))
+	assert.Nil(t, tx)
+	assert.Equal(t, errValueKeyAmountMustNonNegative, err)
+
+	_, err = NewTxInternalDataWithMap(TxTypeEthereumDynamicFee, values(big.NewInt(0)))
+	assert.NoError(t, err)
+}
+
+func TestTxInternalDataEthereumDynamicFee_IsContractCreation(t *testing.T) {
+	to := common.HexToAddress("0x1")
+
+	call := newTxInternalDataEthereumDynamicFeeWithValues(0, &to, nil, 21000, nil, nil, nil, nil, big.NewInt(1))
+	assert.False(t, call.IsContractCreation())
+
+	creation := newTxInternalDataEthereumDynamicFeeWithValues(0, nil, nil, 53000, nil, nil, []byte{0x60}, nil, big.NewInt(1))
+	assert.True(t, creation.IsContractCreation())
+}
+
+func TestTxInternalDataEthereumDynamicFee_StorageKeyCount(t *testing.T) {
+	tx := &TxInternalDataEthereumDynamicFee{}
+	assert.Equal(t, 0, tx.StorageKeyCount())
+
+	tx.AccessList = AccessList{
+		{Address: common.HexToAddress("0x1"), StorageKeys: []common.Hash{{0x1}, {0x2}, {0x3}}},
+		{Address: common.HexToAddress("0x2"), StorageKeys: []common.Hash{}},
+		{Address: common.HexToAddress("0x3"), StorageKeys: []common.Hash{{0x1}}},
+	}
+	assert.Equal(t, 4, tx.StorageKeyCount())
+}
+
+func TestTxInternalDataEthereumDynamicFee_RecipientWarmthGasSaving(t *testing.T) {
+	to := common.HexToAddress("0x1")
+
+	// an unlisted recipient saves nothing
+	tx := &TxInternalDataEthereumDynamicFee{Recipient: &to, AccessList: AccessList{{Address: common.HexToAddress("0x2")}}}
+	assert.Zero(t, tx.RecipientWarmthGasSaving())
+
+	// a listed recipient saves the cold access cost less the warm read cost
+	tx.AccessList = append(tx.AccessList, AccessTuple{Address: to})
+	assert.Equal(t, uint64(2500), tx.RecipientWarmthGasSaving())
+
+	// a contract creation has no recipient
+	tx = &TxInternalDataEthereumDynamicFee{AccessList: AccessList{{Address: to}}}
+	assert.Zero(t, tx.RecipientWarmthGasSaving())
+}
+
+func TestTxInternalDataEthereumDynamicFee_Diff(t *testing.T) {
+	old := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(30), []byte{0x1}, nil, big.NewInt(1))
+	assert.Empty(t, old.Diff(old))
+
+	replacement := old.copyUnsigned()
+	replacement.GasTipCap = big.NewInt(2)
+	replacement.Payload = []byte{0x1, 0x2}
+
+	assert.Equal(t, map[string][2]interface{}{
+		"GasTipCap": {big.NewInt(1), big.NewInt(2)},
+		"Payload":   {[]byte{0x1}, []byte{0x1, 0x2}},
+	}, old.Diff(replacement))
+
+	// the signature is compared as well
+	key, _ := crypto.GenerateKey()
+	signed, err := SignTx(NewTx(replacement), LatestSignerForChainID(big.NewInt(1)), key)
+	assert.NoError(t, err)
+	diff := old.Diff(signed.GetTxInternalData().(*TxInternalDataEthereumDynamicFee))
+	assert.Contains(t, diff, "R")
+	assert.Contains(t, diff, "S")
+	assert.NotContains(t, diff, "Amount")
+}
+
+func TestTxInternalDataEthereumDynamicFee_StructuredDisplay(t *testing.T) {
+	// 1.5 KLAY, 25 ston and 2.5 ston
+	data := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(15e17), 21000,
+		big.NewInt(25e8), big.NewInt(25e9), []byte{0x1, 0x2}, nil, big.NewInt(8217))
+
+	display := data.StructuredDisplay()
+	assert.Equal(t, TxTypeEthereumDynamicFee.String(), display["type"])
+	assert.Equal(t, "8217", display["chainId"])
+	assert.Equal(t, uint64(3), display["nonce"])
+	assert.Equal(t, testAddr.Hex(), display["to"])
+	assert.Equal(t, "1.5 KLAY", display["value"])
+	assert.Equal(t, uint64(21000), display["gas"])
+	assert.Equal(t, "25 ston", display["maxFeePerGas"])
+	assert.Equal(t, "2.5 ston", display["maxPriorityFeePerGas"])
+	assert.Equal(t, "0x0102", display["data"])
+
+	testcases := []struct {
+		amount   *big.Int
+		expected string
+	}{
+		{big.NewInt(0), "0 KLAY"},
+		{big.NewInt(1), "0.000000000000000001 KLAY"},
+		{big.NewInt(params.KLAY), "1 KLAY"},
+		{new(big.Int).Mul(big.NewInt(12345), big.NewInt(params.MiliKLAY)), "12.345 KLAY"},
+	}
+	for _, tc := range testcases {
+		data.Amount = tc.amount
+		assert.Equal(t, tc.expected, data.StructuredDisplay()["value"])
+	}
+
+	// a contract creation has no recipient
+	data.Recipient = nil
+	assert.Nil(t, data.StructuredDisplay()["to"])
+}
+
+func TestTxInternalDataEthereumDynamicFee_EstimatedSize(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	accessList := AccessList{{Address: testAddr, StorageKeys: []common.Hash{{0x1}, {0x2}}}}
+
+	for _, payload := range [][]byte{nil, {0x1, 0x2}, make([]byte, 1000)} {
+		key, _ := crypto.GenerateKey()
+		data := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+			big.NewInt(30), big.NewInt(2), payload, nil, big.NewInt(1))
+		data.AccessList = accessList
+		estimated := data.EstimatedSize()
+
+		tx, err := SignTx(NewTx(data), signer, key)
+		assert.NoError(t, err)
+		// R and S may have a few leading zeros
+		actual := int(tx.Size())
+		assert.GreaterOrEqual(t, estimated, actual)
+		assert.LessOrEqual(t, estimated-actual, 4)
+	}
+}
+
+func TestTxInternalDataEthereumDynamicFee_AccessListChunks(t *testing.T) {
+	var accessList AccessList
+	for i := 0; i < 6; i++ {
+		accessList = append(accessList, AccessTuple{Address: common.BigToAddress(big.NewInt(int64(i)))})
+	}
+	tx := &TxInternalDataEthereumDynamicFee{AccessList: accessList}
+
+	// exact multiple
+	chunks := tx.AccessListChunks(3)
+	assert.Equal(t, []AccessList{accessList[:3], accessList[3:]}, chunks)
+
+	// remainder
+	chunks = tx.AccessListChunks(4)
+	assert.Equal(t, []AccessList{accessList[:4], accessList[4:]}, chunks)
+
+	// larger than the list
+	chunks = tx.AccessListChunks(10)
+	assert.Equal(t, []AccessList{accessList}, chunks)
+
+	// non-positive size
+	assert.Equal(t, []AccessList{accessList}, tx.AccessListChunks(0))
+	assert.Equal(t, []AccessList{accessList}, tx.AccessListChunks(-1))
+
+	// empty access list
+	tx.AccessList = AccessList{}
+	assert.Empty(t, tx.AccessListChunks(3))
+}
+
+func TestTxInternalDataEthereumDynamicFee_Signer(t *testing.T) {
+	chainID := big.NewInt(1001)
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, chainID)), LatestSignerForChainID(chainID), key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	signer := data.Signer()
+	assert.Equal(t, chainID, signer.ChainID())
+
+	from, err := Sender(signer, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), from)
+}
+
+func TestTxInternalDataEthereumDynamicFee_InvalidateCaches(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, big.NewInt(1))), signer, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	oldHash := data.TxHash()
+	data.SetHash(&oldHash)
+	assert.Equal(t, oldHash, tx.Hash())
+
+	data.AccountNonce++
+	data.InvalidateCaches()
+	assert.Nil(t, data.GetHash())
+
+	newHash := data.TxHash()
+	assert.NotEqual(t, oldHash, newHash)
+
+	// the caches of the wrapping transaction are not cleared, so the mutated data must be wrapped again
+	assert.Equal(t, oldHash, tx.Hash())
+	assert.Equal(t, newHash, NewTx(data).Hash())
+
+	// mutators invalidate the caches by themselves
+	data.SetHash(&newHash)
+	data.SetSignature(TxSignatures{&TxSignature{big.NewInt(1), big.NewInt(2), big.NewInt(3)}})
+	assert.Nil(t, data.GetHash())
+}
+
+func TestTxInternalDataEthereumDynamicFee_EthCompatibleMarshalJSON(t *testing.T) {
+	to := common.HexToAddress("0x000000000000000000000000000000000000beef")
+	tx := newTxInternalDataEthereumDynamicFeeWithValues(3, &to, big.NewInt(10), 21000, big.NewInt(2), big.NewInt(30),
+		[]byte{0x1, 0x2}, nil, big.NewInt(1))
+	tx.AccessList = AccessList{{Address: to, StorageKeys: []common.Hash{{0x1}}}}
+	tx.SetSignature(TxSignatures{&TxSignature{big.NewInt(1), big.NewInt(0xaa), big.NewInt(0xbb)}})
+
+	expected := `{"type":"0x2","chainId":"0x1","nonce":"0x3","to":"0x000000000000000000000000000000000000beef",` +
+		`"gas":"0x5208","maxPriorityFeePerGas":"0x2","maxFeePerGas":"0x1e","value":"0xa","input":"0x0102",` +
+		`"accessList":[{"address":"0x000000000000000000000000000000000000beef",` +
+		`"storageKeys":["0x0100000000000000000000000000000000000000000000000000000000000000"]}],` +
+		`"v":"0x1","r":"0xaa","s":"0xbb","yParity":"0x1"}`
+	enc, err := tx.EthCompatibleMarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(enc))
+
+	// the Klaytn format is unchanged
+	enc, err = tx.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(enc), `"typeInt":30722,"type":"TxTypeEthereumDynamicFee"`)
+}
+
+func TestTxInternalDataEthereumDynamicFee_UnmarshalJSONAccessList(t *testing.T) {
+	orig := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, nil, big.NewInt(1))
+	orig.AccessList = AccessList{{Address: accessAddr, StorageKeys: []common.Hash{common.HexToHash("0x1")}}}
+
+	// Klaytn-shaped access list
+	enc, err := json.Marshal(orig)
+	assert.NoError(t, err)
+
+	decoded := newEmptyTxInternalDataEthereumDynamicFee()
+	assert.NoError(t, json.Unmarshal(enc, decoded))
+	assert.Equal(t, orig.AccessList, decoded.AccessList)
+
+	// Ethereum-shaped access list with omitted storage keys
+	var fields map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(enc, &fields))
+	fields["accessList"] = json.RawMessage(`[{"address":"0x0000000000000000000000000000000000000001","storageKeys":["0x` + strings.Repeat("00", 31) + `01"]},{"address":"0x000000000000000000000000000000000000dead"}]`)
+	enc, err = json.Marshal(fields)
+	assert.NoError(t, err)
+
+	decoded = newEmptyTxInternalDataEthereumDynamicFee()
+	assert.NoError(t, json.Unmarshal(enc, decoded))
+	assert.Equal(t, AccessList{
+		{Address: accessAddr, StorageKeys: []common.Hash{common.HexToHash("0x1")}},
+		{Address: common.HexToAddress("0xdead"), StorageKeys: []common.Hash{}},
+	}, decoded.AccessList)
+
+	// a truncated storage key is rejected
+	fields["accessList"] = json.RawMessage(`[{"address":"0x0000000000000000000000000000000000000001","storageKeys":["0x` + strings.Repeat("00", 30) + `01"]}]`)
+	enc, err = json.Marshal(fields)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, json.Unmarshal(enc, newEmptyTxInternalDataEthereumDynamicFee()), errShortStorageKey)
+
+	// invalid in both formats
+	fields["accessList"] = json.RawMessage(`[{"storageKeys":[]}]`)
+	enc, err = json.Marshal(fields)
+	assert.NoError(t, err)
+	assert.Error(t, json.Unmarshal(enc, newEmptyTxInternalDataEthereumDynamicFee()))
+}
+
+func TestAccessList_MergeAccessList(t *testing.T) {
+	addr1, addr2, addr3 := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+
+	// overlapping
+	user := AccessList{
+		{Address: addr1, StorageKeys: []common.Hash{{1}, {2}}},
+		{Address: addr2, StorageKeys: []common.Hash{}},
+	}
+	generated := AccessList{
+		{Address: addr2, StorageKeys: []common.Hash{{3}}},
+		{Address: addr1, StorageKeys: []common.Hash{{2}, {4}, {4}}},
+	}
+	merged := user.MergeAccessList(generated)
+	assert.Equal(t, AccessList{
+		{Address: addr1, StorageKeys: []common.Hash{{1}, {2}, {4}}},
+		{Address: addr2, StorageKeys: []common.Hash{{3}}},
+	}, merged)
+	assert.Equal(t, 4, merged.StorageKeys())
+
+	// the inputs are not modified
+	assert.Equal(t, AccessList{
+		{Address: addr1, StorageKeys: []common.Hash{{1}, {2}}},
+		{Address: addr2, StorageKeys: []common.Hash{}},
+	}, user)
+
+	// disjoint
+	merged = user.MergeAccessList(AccessList{{Address: addr3, StorageKeys: []common.Hash{{5}}}})
+	assert.Equal(t, AccessList{
+		{Address: addr1, StorageKeys: []common.Hash{{1}, {2}}},
+		{Address: addr2, StorageKeys: []common.Hash{}},
+		{Address: addr3, StorageKeys: []common.Hash{{5}}},
+	}, merged)
+
+	// duplicates within a single list are merged as well
+	assert.Equal(t, AccessList{{Address: addr1, StorageKeys: []common.Hash{{1}}}},
+		AccessList{{Address: addr1, StorageKeys: []common.Hash{{1}}}, {Address: addr1, StorageKeys: []common.Hash{{1}}}}.MergeAccessList(nil))
+	assert.Equal(t, AccessList{}, AccessList(nil).MergeAccessList(nil))
+}
+
+func TestParseEthAccessList(t *testing.T) {
+	accessList, err := ParseEthAccessList(json.RawMessage(`null`))
+	assert.NoError(t, err)
+	assert.Nil(t, accessList)
+
+	accessList, err = ParseEthAccessList(json.RawMessage(`[{"address":"0x0000000000000000000000000000000000000001","storageKeys":null}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, AccessList{{Address: accessAddr, StorageKeys: []common.Hash{}}}, accessList)
+
+	_, err = ParseEthAccessList(json.RawMessage(`[{"address":"0x0000000000000000000000000000000000000001","storageKeys":["0x` + strings.Repeat("00", 33) + `"]}]`))
+	assert.Error(t, err)
+}
+
+func TestTxInternalDataEthereumDynamicFee_PayloadByteStats(t *testing.T) {
+	testcases := []struct {
+		payload string
+		zero    int
+		nonzero int
+	}{
+		{"", 0, 0},
+		{"000000", 3, 0},
+		{"ff3d01", 0, 3},
+		{"0000a6bc00fd", 3, 3},
+	}
+
+	for _, tc := range testcases {
+		tx := &TxInternalDataEthereumDynamicFee{Payload: common.FromHex(tc.payload)}
+		zero, nonzero := tx.PayloadByteStats()
+		assert.Equal(t, tc.zero, zero, tc.payload)
+		assert.Equal(t, tc.nonzero, nonzero, tc.payload)
+	}
+}
+
+// noChainIDSigner is a signer whose chain ID is unset.
+type noChainIDSigner struct{ Signer }
+
+func (noChainIDSigner) ChainID() *big.Int { return nil }
+
+func TestTxInternalDataEthereumDynamicFee_ReSign(t *testing.T) {
+	oldChainID, newChainID := big.NewInt(1), big.NewInt(1001)
+	oldSigner, newSigner := LatestSignerForChainID(oldChainID), LatestSignerForChainID(newChainID)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(1), nil, AccessList{}, oldChainID)), oldSigner, key)
+	assert.NoError(t, err)
+	data := tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	assert.ErrorIs(t, data.ReSign(oldSigner, key, newChainID), errSignerChainID)
+	assert.ErrorIs(t, data.ReSign(nil, key, newChainID), errSignerNoChainID)
+	assert.ErrorIs(t, data.ReSign(noChainIDSigner{newSigner}, key, newChainID), errSignerNoChainID)
+	assert.NoError(t, data.ReSign(newSigner, key, newChainID))
+	assert.Equal(t, newChainID, data.ChainID)
+
+	from, err := Sender(newSigner, NewTx(data))
+	assert.NoError(t, err)
+	assert.Equal(t, addr, from)
+
+	_, err = Sender(oldSigner, NewTx(data))
+	assert.Error(t, err)
+}
+
+func TestTxInternalDataEthereumDynamicFee_SetSignatureE(t *testing.T) {
+	tx := newTxInternalDataEthereumDynamicFee()
+	sig := &TxSignature{big.NewInt(1), big.NewInt(2), big.NewInt(3)}
+
+	err := tx.SetSignatureE(TxSignatures{sig, sig})
+	var countErr *SignatureCountError
+	assert.ErrorAs(t, err, &countErr)
+	assert.Equal(t, 1, countErr.Expected)
+	assert.Equal(t, 2, countErr.Actual)
+	assert.Zero(t, tx.V.Sign())
+
+	assert.NoError(t, tx.SetSignatureE(TxSignatures{sig}))
+	assert.Equal(t, TxSignatures{sig}, tx.RawSignatureValues())
+
+	// JSON with a wrong number of signatures is rejected instead of panicking
+	enc, err := json.Marshal(tx)
+	assert.NoError(t, err)
+	var fields map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(enc, &fields))
+	fields["signatures"] = json.RawMessage(`[]`)
+	enc, err = json.Marshal(fields)
+	assert.NoError(t, err)
+	assert.ErrorAs(t, json.Unmarshal(enc, newEmptyTxInternalDataEthereumDynamicFee()), &countErr)
+	assert.Equal(t, 0, countErr.Actual)
+}
+
+func TestTxInternalDataEthereumDynamicFee_UnmarshalJSONConsistency(t *testing.T) {
+	enc, err := json.Marshal(&dynamicFeeTx)
+	assert.NoError(t, err)
+	var fields map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(enc, &fields))
+
+	unmarshal := func(fields map[string]json.RawMessage) error {
+		enc, err := json.Marshal(fields)
+		assert.NoError(t, err)
+		return json.Unmarshal(enc, newEmptyTxInternalDataEthereumDynamicFee())
+	}
+	assert.NoError(t, unmarshal(fields))
+
+	// wrong type
+	fields["typeInt"] = json.RawMessage(fmt.Sprint(uint16(TxTypeEthereumAccessList)))
+	assert.ErrorIs(t, unmarshal(fields), errJSONTxType)
+	delete(fields, "typeInt")
+	assert.ErrorIs(t, unmarshal(fields), errJSONTxType)
+	fields["typeInt"] = json.RawMessage(fmt.Sprint(uint16(TxTypeEthereumDynamicFee)))
+
+	// missing chain ID
+	delete(fields, "chainId")
+	assert.ErrorIs(t, unmarshal(fields), errJSONNoChainID)
+}
+
+func TestTxInternalDataEthereumDynamicFee_GettersReturnCopies(t *testing.T) {
+	to := common.HexToAddress("0x1")
+	txdata := newTxInternalDataEthereumDynamicFeeWithValues(0, &to, big.NewInt(100), 21000, big.NewInt(2), big.NewInt(30), nil, AccessList{}, big.NewInt(1))
+
+	txdata.GetAmount().SetInt64(1)
+	txdata.GetGasTipCap().SetInt64(1)
+	txdata.GetGasFeeCap().SetInt64(1)
+
+	assert.Equal(t, big.NewInt(100), txdata.Amount)
+	assert.Equal(t, big.NewInt(2), txdata.GasTipCap)
+	assert.Equal(t, big.NewInt(30), txdata.GasFeeCap)
+}
+
+func TestFromLegacy(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x1")
+	chainID := big.NewInt(1)
+
+	legacyTx, err := SignTx(NewTx(newTxInternalDataLegacyWithValues(7, &to, big.NewInt(10), 30000, big.NewInt(25), []byte{0x1})),
+		NewEIP155Signer(chainID), key)
+	assert.NoError(t, err)
+	legacy := legacyTx.GetTxInternalData().(*TxInternalDataLegacy)
+
+	txdata, err := FromLegacy(legacy, big.NewInt(2))
+	assert.NoError(t, err)
+	assert.Equal(t, chainID, txdata.ChainID)
+	assert.Equal(t, uint64(7), txdata.AccountNonce)
+	assert.Equal(t, to, *txdata.Recipient)
+	assert.Equal(t, big.NewInt(10), txdata.Amount)
+	assert.Equal(t, uint64(30000), txdata.GasLimit)
+	assert.Equal(t, big.NewInt(25), txdata.GasFeeCap)
+	assert.Equal(t, big.NewInt(2), txdata.GasTipCap)
+	assert.Equal(t, []byte{0x1}, txdata.Payload)
+
+	// the signature of the legacy transaction is not carried over
+	assert.False(t, txdata.ValidateSignature())
+	signer := LatestSignerForChainID(chainID)
+	_, err = Sender(signer, NewTx(txdata))
+	assert.Error(t, err)
+
+	tx, err := SignTx(NewTx(txdata), signer, key)
+	assert.NoError(t, err)
+	sender, err := Sender(signer, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, from, sender)
+
+	// the tip cannot exceed the gas price, and the legacy transaction must be replay-protected
+	_, err = FromLegacy(legacy, big.NewInt(26))
+	assert.Equal(t, errTipAbovePrice, err)
+	_, err = FromLegacy(legacy, big.NewInt(-1))
+	assert.Equal(t, errInvalidTip, err)
+	_, err = FromLegacy(newTxInternalDataLegacyWithValues(7, &to, big.NewInt(10), 30000, big.NewInt(25), nil), big.NewInt(2))
+	assert.Equal(t, errLegacyNotProtected, err)
+}
+
+func TestTxInternalDataEthereumDynamicFee_PrecompiledAccessListAddresses(t *testing.T) {
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	accessList := AccessList{
+		{Address: testAddr, StorageKeys: []common.Hash{}},
+		{Address: common.BytesToAddress([]byte{0x1}), StorageKeys: []common.Hash{}}, // ecrecover
+	}
+	txdata := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 1e6,
+		big.NewInt(1), big.NewInt(1), nil, nil, big.NewInt(1))
+	txdata.AccessList = accessList
+	tx, err := SignTx(NewTx(txdata), signer, key)
+	assert.NoError(t, err)
+	txdata = tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee)
+
+	// a precompiled contract address is reported, but the transaction is still valid
+	assert.Equal(t, []common.Address{common.BytesToAddress([]byte{0x1})}, txdata.PrecompiledAccessListAddresses())
+	assert.NoError(t, txdata.Validate(nil, 0))
+
+	txdata.AccessList = accessList[:1]
+	assert.Empty(t, txdata.PrecompiledAccessListAddresses())
+}
+
+func TestDecodeDynamicFeeRawTx(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	tx, err := SignTx(NewTx(newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(30), []byte{0x1}, AccessList{}, big.NewInt(1))), LatestSignerForChainID(big.NewInt(1)), key)
+	assert.NoError(t, err)
+
+	// Klaytn encodes the transaction with the envelope type byte, Ethereum without it
+	klaytnRaw, err := tx.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(EthereumTxTypeEnvelope), klaytnRaw[0])
+	ethRaw := klaytnRaw[1:]
+
+	for _, raw := range [][]byte{ethRaw, klaytnRaw} {
+		txdata, sender, err := DecodeDynamicFeeRawTx(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, from, sender)
+		assert.True(t, txdata.Equal(tx.GetTxInternalData()))
+	}
+
+	// malformed prefixes
+	_, _, err = DecodeDynamicFeeRawTx(append([]byte{0x01}, ethRaw[1:]...))
+	assert.ErrorIs(t, err, errNotDynamicFeeRawTx)
+	_, _, err = DecodeDynamicFeeRawTx(ethRaw[1:])
+	assert.ErrorIs(t, err, errNotDynamicFeeRawTx)
+	_, _, err = DecodeDynamicFeeRawTx(nil)
+	assert.ErrorIs(t, err, errNotDynamicFeeRawTx)
+}
+
+func TestDynamicFeeBatch(t *testing.T) {
+	signer := LatestSignerForChainID(big.NewInt(1))
+	key, _ := crypto.GenerateKey()
+	other := common.HexToAddress("0xbeef")
+
+	var txs []*TxInternalDataEthereumDynamicFee
+	for i, to := range []*common.Address{&testAddr, nil, &other} {
+		data := newTxInternalDataEthereumDynamicFeeWithValues(uint64(i), to, big.NewInt(10), 100000,
+			big.NewInt(1), big.NewInt(30), []byte{byte(i)}, nil, big.NewInt(1))
+		data.AccessList = AccessList{{Address: other, StorageKeys: []common.Hash{{byte(i)}}}}
+		tx, err := SignTx(NewTx(data), signer, key)
+		assert.NoError(t, err)
+		txs = append(txs, tx.GetTxInternalData().(*TxInternalDataEthereumDynamicFee))
+	}
+
+	enc, err := EncodeDynamicFeeBatch(txs)
+	assert.NoError(t, err)
+	decoded, err := DecodeDynamicFeeBatch(enc)
+	assert.NoError(t, err)
+	assert.Len(t, decoded, len(txs))
+	for i := range txs {
+		assert.True(t, txs[i].Equal(decoded[i]), "tx %d", i)
+		assert.Equal(t, txs[i].TxHash(), decoded[i].TxHash())
+	}
+	assert.True(t, decoded[1].IsContractCreation())
+
+	// the elements are the raw transactions in the Ethereum format
+	var elems [][]byte
+	assert.NoError(t, rlp.DecodeBytes(enc, &elems))
+	for i, elem := range elems {
+		raw, err := NewTx(txs[i]).MarshalBinary()
+		assert.NoError(t, err)
+		assert.Equal(t, raw[1:], elem)
+	}
+
+	// an empty batch
+	enc, err = EncodeDynamicFeeBatch(nil)
+	assert.NoError(t, err)
+	decoded, err = DecodeDynamicFeeBatch(enc)
+	assert.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	// an element of another type
+	enc, err = rlp.EncodeToBytes([][]byte{append([]byte{0x01}, elems[0][1:]...)})
+	assert.NoError(t, err)
+	_, err = DecodeDynamicFeeBatch(enc)
+	assert.ErrorIs(t, err, errNotDynamicFeeRawTx)
+}
+
+func TestTxInternalDataEthereumDynamicFee_UnsignedBytes(t *testing.T) {
+	accessList := AccessList{{Address: testAddr, StorageKeys: []common.Hash{{0x1}}}}
+	txdata := newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), 25000,
+		big.NewInt(1), big.NewInt(30), []byte{0x1}, nil, big.NewInt(1))
+	txdata.AccessList = accessList
+
+	for _, chainID := range []*big.Int{big.NewInt(1), big.NewInt(1001)} {
+		unsigned, err := txdata.UnsignedBytes(chainID)
+		assert.NoError(t, err)
+		assert.Equal(t, byte(0x02), unsigned[0])
+
+		tx := NewTx(txdata)
+		if chainID.Cmp(txdata.ChainID) != 0 {
+			// the signer fills the chain ID only if the transaction has none
+			cpy := txdata.copyUnsigned()
+			cpy.ChainID = new(big.Int)
+			tx = NewTx(cpy)
+		}
+		assert.Equal(t, LatestSignerForChainID(chainID).Hash(tx), crypto.Keccak256Hash(unsigned))
+	}
+
+	_, err := txdata.UnsignedBytes(nil)
+	assert.Equal(t, errSignNoChainID, err)
+}
+
+func TestTxInternalDataEthereumDynamicFee_PoolKey(t *testing.T) {
+	from := common.HexToAddress("0xABCDEF0123456789abcdef0123456789ABCDEF01")
+	newTx := func(nonce uint64, tip int64) *TxInternalDataEthereumDynamicFee {
+		return newTxInternalDataEthereumDynamicFeeWithValues(nonce, &testAddr, big.NewInt(10), 25000,
+			big.NewInt(tip), big.NewInt(30), nil, nil, big.NewInt(1))
+	}
+
+	key := newTx(3, 1).PoolKey(from)
+	assert.Equal(t, "0xabcdef0123456789abcdef0123456789abcdef01:3", key)
+
+	// the same sender and nonce give the same key regardless of the other fields
+	assert.Equal(t, key, newTx(3, 2).PoolKey(from))
+	assert.NotEqual(t, key, newTx(4, 1).PoolKey(from))
+	assert.NotEqual(t, key, newTx(3, 1).PoolKey(testAddr))
+
+	assert.Equal(t, "0xabcdef0123456789abcdef0123456789abcdef01:18446744073709551615", newTx(math.MaxUint64, 1).PoolKey(from))
+}
+
+func TestTxInternalDataEthereumDynamicFee_ValidateGasLimit(t *testing.T) {
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{IstanbulCompatibleBlock: big.NewInt(0)})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	newTx := func(gasLimit uint64) *TxInternalDataEthereumDynamicFee {
+		return newTxInternalDataEthereumDynamicFeeWithValues(3, &testAddr, big.NewInt(10), gasLimit,
+			big.NewInt(1), big.NewInt(30), []byte{0x1}, nil, big.NewInt(1))
+	}
+	intrinsic := params.TxGas + params.TxDataGas
+
+	// at the block gas limit
+	assert.NoError(t, newTx(100000).ValidateGasLimit(100000, 0))
+	assert.Equal(t, errGasLimitAboveBlock, newTx(100001).ValidateGasLimit(100000, 0))
+
+	// at and below the intrinsic gas
+	assert.NoError(t, newTx(intrinsic).ValidateGasLimit(100000, 0))
+	assert.Equal(t, errGasLimitIntrinsic, newTx(intrinsic-1).ValidateGasLimit(100000, 0))
+}
@@ -858,3 +858,36 @@ func testTxRLPDecodeDynamicFee(t *testing.T) {
 		t.Fatalf("tx != dec.tx\ntx=%v\ndec.tx=%v", tx, dec.tx)
 	}
 }
+
+func TestDecodeTypedTx(t *testing.T) {
+	for _, txdata := range []TxInternalData{
+		genLegacyTransaction(),
+		genAccessListTransaction(),
+		genDynamicFeeTransaction(),
+		genValueTransferTransaction(),
+		genFeeDelegatedSmartContractExecutionWithRatioTransaction(),
+	} {
+		raw, err := NewTx(txdata).MarshalBinary()
+		assert.NoError(t, err)
+
+		decoded, err := DecodeTypedTx(raw)
+		assert.NoError(t, err)
+		assert.Equal(t, txdata.Type(), decoded.Type())
+		assert.True(t, txdata.Equal(decoded))
+
+		// Ethereum typed transactions are also accepted without the envelope
+		if txdata.Type().IsEthTypedTransaction() {
+			decoded, err = DecodeTypedTx(raw[1:])
+			assert.NoError(t, err)
+			assert.True(t, txdata.Equal(decoded))
+		}
+	}
+
+	// unknown type bytes
+	for _, raw := range [][]byte{{0x00, 0xc0}, {0x03, 0xc0}, {0x18, 0xc0}, {0x78, 0x03, 0xc0}} {
+		_, err := DecodeTypedTx(raw)
+		assert.ErrorIs(t, err, errUndefinedTxType)
+	}
+	_, err := DecodeTypedTx(nil)
+	assert.ErrorIs(t, err, errEmptyTypedTx)
+}
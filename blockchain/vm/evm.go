@@ -665,6 +665,19 @@ func (evm *EVM) GetPrecompiledContractMap(addr common.Address) map[common.Addres
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
 
+// Rules returns the chain rules of the current block.
+func (evm *EVM) Rules() params.Rules { return evm.chainRules }
+
+// TracedAccessList returns the access list collected by the AccessListTracer of the EVM.
+// It returns false if the EVM is not running with an AccessListTracer.
+func (evm *EVM) TracedAccessList() (types.AccessList, bool) {
+	tracer, ok := evm.Config.Tracer.(*AccessListTracer)
+	if !ok || !evm.Config.Debug {
+		return nil, false
+	}
+	return tracer.AccessList(), true
+}
+
 // Interpreter returns the EVM interpreter
 func (evm *EVM) Interpreter() *EVMInterpreter { return evm.interpreter }
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/kerrors"
 	"github.com/klaytn/klaytn/params"
@@ -132,3 +133,125 @@ func TestBn256GasCost(t *testing.T) {
 		{"0x008", bn256PairingInput, true, Block5, params.Bn256PairingBaseGasIstanbul + params.Bn256PairingPerPointGasIstanbul*uint64(len(bn256PairingInput)/192), bn256PairingOutput, nil},
 	})
 }
+
+func TestGenerateAccessList(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x1000")
+		contract = common.HexToAddress("0x2000")
+		// PUSH1 0x01 SLOAD PUSH1 0x02 SLOAD PUSH2 0x3000 BALANCE STOP
+		code   = common.Hex2Bytes("600154600254613000310000")
+		config = &params.ChainConfig{IstanbulCompatibleBlock: big.NewInt(0), LondonCompatibleBlock: big.NewInt(0)}
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+	statedb.CreateSmartContractAccount(contract, params.CodeFormatEVM, config.Rules(big.NewInt(0)))
+	statedb.SetCode(contract, code)
+
+	blockCtx := BlockContext{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(0),
+	}
+	tx := &types.TxInternalDataEthereumDynamicFee{
+		ChainID:   big.NewInt(1),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		GasLimit:  100000,
+		Recipient: &contract,
+		Amount:    new(big.Int),
+	}
+
+	// The access list cannot be generated without an access list tracer.
+	vmenv := NewEVM(blockCtx, TxContext{}, statedb, config, &Config{})
+	_, _, err := tx.GenerateAccessList(statedb, vmenv, from)
+	assert.Error(t, err)
+
+	tracer := NewAccessListTracer(nil, from, contract, ActivePrecompiles(vmenv.Rules()))
+	vmenv = NewEVM(blockCtx, TxContext{}, statedb, config, &Config{Debug: true, Tracer: tracer})
+	acl, gas, err := tx.GenerateAccessList(statedb, vmenv, from)
+	assert.NoError(t, err)
+
+	slots := map[common.Address]int{}
+	for _, tuple := range acl {
+		slots[tuple.Address] = len(tuple.StorageKeys)
+	}
+	assert.Equal(t, map[common.Address]int{contract: 2, common.HexToAddress("0x3000"): 0}, slots)
+	assert.Equal(t, params.TxGas+2*params.TxAccessListAddressGas+2*params.TxAccessListStorageKeyGas, gas)
+}
+
+func TestSimulateExecute(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x1000")
+		contract = common.HexToAddress("0x2000")
+		// PUSH1 0x2a PUSH1 0x01 SSTORE PUSH1 0x2a PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+		code   = common.Hex2Bytes("602a600155602a60005260206000f3")
+		config = &params.ChainConfig{IstanbulCompatibleBlock: big.NewInt(0), LondonCompatibleBlock: big.NewInt(0)}
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+	statedb.CreateSmartContractAccount(contract, params.CodeFormatEVM, config.Rules(big.NewInt(0)))
+	statedb.SetCode(contract, code)
+	root := statedb.IntermediateRoot(false)
+
+	blockCtx := BlockContext{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(0),
+	}
+	vmenv := NewEVM(blockCtx, TxContext{}, statedb, config, &Config{})
+	tx := &types.TxInternalDataEthereumDynamicFee{Recipient: &contract}
+
+	ret, usedGas, err := tx.SimulateExecute(types.NewAccountRefWithFeePayer(from, from), vmenv, statedb, 0, 100000, new(big.Int))
+	assert.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(0x2a)).Bytes(), ret)
+	assert.True(t, usedGas > params.SstoreSetGas)
+
+	// neither the storage nor the nonce of the sender is changed
+	assert.Equal(t, common.Hash{}, statedb.GetState(contract, common.BigToHash(big.NewInt(1))))
+	assert.Equal(t, uint64(0), statedb.GetNonce(from))
+	assert.Equal(t, root, statedb.IntermediateRoot(false))
+}
+
+func TestIsAccessListGasEfficient(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x1000")
+		contract = common.HexToAddress("0x2000")
+		used     = common.HexToAddress("0x3000")
+		unused   = common.HexToAddress("0x4000")
+		// PUSH1 0x01 SLOAD PUSH1 0x02 SLOAD PUSH2 0x3000 BALANCE STOP
+		code   = common.Hex2Bytes("600154600254613000310000")
+		config = &params.ChainConfig{IstanbulCompatibleBlock: big.NewInt(0), LondonCompatibleBlock: big.NewInt(0)}
+	)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+	statedb.CreateSmartContractAccount(contract, params.CodeFormatEVM, config.Rules(big.NewInt(0)))
+	statedb.SetCode(contract, code)
+
+	blockCtx := BlockContext{
+		CanTransfer: func(StateDB, common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *big.Int) {},
+		BlockNumber: big.NewInt(0),
+	}
+	check := func(accessList types.AccessList) (bool, types.AccessList) {
+		tx := &types.TxInternalDataEthereumDynamicFee{GasLimit: 100000, Recipient: &contract, Amount: new(big.Int), AccessList: accessList}
+		tracer := NewAccessListTracer(nil, from, contract, ActivePrecompiles(config.Rules(big.NewInt(0))))
+		vmenv := NewEVM(blockCtx, TxContext{}, statedb, config, &Config{Debug: true, Tracer: tracer})
+		return tx.IsAccessListGasEfficient(statedb, vmenv, from)
+	}
+
+	efficient, optimal := check(types.AccessList{{Address: used, StorageKeys: []common.Hash{}}})
+	assert.True(t, efficient)
+	assert.Len(t, optimal, 2)
+
+	// a declared but unused entry costs more than the touched entry saves
+	efficient, optimal = check(types.AccessList{
+		{Address: used, StorageKeys: []common.Hash{}},
+		{Address: unused, StorageKeys: []common.Hash{}},
+	})
+	assert.False(t, efficient)
+	for _, tuple := range optimal {
+		assert.NotEqual(t, unused, tuple.Address)
+	}
+
+	// nothing is saved without an access list
+	efficient, _ = check(nil)
+	assert.False(t, efficient)
+	assert.Equal(t, uint64(0), statedb.GetNonce(from))
+}
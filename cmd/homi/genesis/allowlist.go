@@ -0,0 +1,33 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package genesis
+
+import (
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+// The storage layout of contracts/allowlist/Allowlist.sol.
+const (
+	allowlistAdminSlot     = 0
+	allowlistIsAllowedSlot = 1 // isAllowed[account] at keccak256(account . 1)
+)
+
+// AllowlistAddress returns the address of the allowlist contract of the admin installed by AllocAllowlist.
+func AllowlistAddress(admin common.Address) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("allowlist"), admin.Bytes()))
+}
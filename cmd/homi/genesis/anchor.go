@@ -0,0 +1,64 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package genesis
+
+import (
+	"math/big"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+// The storage layout of contracts/bridge/Bridge.sol that its constructor initializes.
+const (
+	anchorOwnerSlot              = 4  // Ownable._owner
+	anchorOperatorsSlot          = 12 // operators[operator] at keccak256(operator . 12)
+	anchorOperatorListSlot       = 13 // operatorList length, with the elements from keccak256(13)
+	anchorOperatorThresholdsSlot = 14 // operatorThresholds[voteType] at keccak256(voteType . 14)
+	anchorConfigurationSlot      = 15 // configurationNonce, modeMintBurn, isRunning, requestNonce, lowerHandleNonce
+	anchorHandleNonceSlot        = 16 // upperHandleNonce, recoveryBlockNumber
+)
+
+// The number of vote types of the bridge, which are ValueTransfer and Configuration.
+const anchorVoteTypes = 2
+
+// anchorStorage returns the storage of the bridge contract right after it is deployed by
+// the operator without the mint-burn mode.
+func anchorStorage(operator common.Address) map[common.Hash]common.Hash {
+	slot := func(n int64) common.Hash { return common.BigToHash(big.NewInt(n)) }
+	one := common.BigToHash(common.Big1)
+
+	storage := make(map[common.Hash]common.Hash)
+	storage[slot(anchorOwnerSlot)] = operator.Hash()
+	storage[crypto.Keccak256Hash(operator.Hash().Bytes(), slot(anchorOperatorsSlot).Bytes())] = one
+	storage[slot(anchorOperatorListSlot)] = one
+	storage[crypto.Keccak256Hash(slot(anchorOperatorListSlot).Bytes())] = operator.Hash()
+	// isRunning = true at the 10th byte from the right
+	storage[slot(anchorConfigurationSlot)] = common.BigToHash(new(big.Int).Lsh(common.Big1, 72))
+	// recoveryBlockNumber = 1 at the 9th byte from the right
+	storage[slot(anchorHandleNonceSlot)] = common.BigToHash(new(big.Int).Lsh(common.Big1, 64))
+	for voteType := int64(0); voteType < anchorVoteTypes; voteType++ {
+		storage[crypto.Keccak256Hash(slot(voteType).Bytes(), slot(anchorOperatorThresholdsSlot).Bytes())] = one
+	}
+	return storage
+}
+
+// ServiceChainAnchorAddress returns the address of the anchoring bridge contract of the operator
+// installed by AllocServiceChainAnchor.
+func ServiceChainAnchorAddress(operator common.Address) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("anchor"), operator.Bytes()))
+}
@@ -18,7 +18,9 @@
 package genesis
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
+	"errors"
 	"math/big"
 	"os"
 	"path/filepath"
@@ -26,6 +28,8 @@ import (
 
 	"github.com/klaytn/klaytn/blockchain"
 	istcommon "github.com/klaytn/klaytn/cmd/homi/common"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/params"
 )
 
@@ -70,6 +74,36 @@ func NewClique(options ...Option) *blockchain.Genesis {
 	return genesis
 }
 
+var errInvalidValidatorNum = errors.New("the number of validators must be positive")
+
+// GenerateNetwork creates numValidators validator keys and returns an Istanbul genesis
+// whose extra data lists the validators and whose alloc funds each of them.
+// The given options are applied after the validators and their allocations.
+func GenerateNetwork(numValidators int, options ...Option) (*blockchain.Genesis, []*ecdsa.PrivateKey, error) {
+	if numValidators <= 0 {
+		return nil, nil, errInvalidValidatorNum
+	}
+
+	keys := make([]*ecdsa.PrivateKey, numValidators)
+	addrs := make([]common.Address, numValidators)
+	for i := 0; i < numValidators; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = key
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+
+	opts := []Option{
+		Validators(addrs...),
+		Alloc(addrs, new(big.Int).Exp(big.NewInt(10), big.NewInt(50), nil)),
+	}
+	opts = append(opts, options...)
+
+	return New(opts...), keys, nil
+}
+
 func NewFileAt(dir string, options ...Option) string {
 	genesis := New(options...)
 	if err := Save(dir, genesis); err != nil {
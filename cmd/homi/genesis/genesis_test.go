@@ -0,0 +1,953 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package genesis
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/accounts/abi/bind/backends"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/system"
+	"github.com/klaytn/klaytn/blockchain/types"
+	istcommon "github.com/klaytn/klaytn/cmd/homi/common"
+	"github.com/klaytn/klaytn/cmd/homi/extra"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/consensus/clique"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/contracts/allowlist"
+	"github.com/klaytn/klaytn/contracts/bridge"
+	"github.com/klaytn/klaytn/contracts/multisig"
+	"github.com/klaytn/klaytn/contracts/reward/contract"
+	"github.com/klaytn/klaytn/contracts/vesting"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNetwork(t *testing.T) {
+	numValidators := 4
+	g, keys, err := GenerateNetwork(numValidators, ChainID(big.NewInt(1000)))
+	require.NoError(t, err)
+	require.Len(t, keys, numValidators)
+	assert.Equal(t, big.NewInt(1000), g.Config.ChainID)
+
+	_, istanbulExtra, err := extra.Decode(hexutil.Encode(g.ExtraData))
+	require.NoError(t, err)
+	require.Len(t, istanbulExtra.Validators, numValidators)
+
+	for i, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		assert.Equal(t, addr, istanbulExtra.Validators[i])
+
+		account, ok := g.Alloc[addr]
+		assert.True(t, ok)
+		assert.True(t, account.Balance.Sign() > 0)
+	}
+
+	_, _, err = GenerateNetwork(0)
+	assert.ErrorIs(t, err, errInvalidValidatorNum)
+}
+
+func TestGovernanceVotingOptions(t *testing.T) {
+	g := New(UseGiniCoeff(true))
+	assert.True(t, g.Config.Governance.Reward.UseGiniCoeff)
+	assert.Equal(t, params.DefaultStakeUpdateInterval, g.Config.Governance.Reward.StakingUpdateInterval)
+	assert.Equal(t, params.DefaultGovernanceMode, g.Config.Governance.GovernanceMode)
+	assert.Nil(t, g.Config.Istanbul)
+
+	g = New(Epoch(3600))
+	assert.Equal(t, uint64(3600), g.Config.Istanbul.Epoch)
+	assert.Equal(t, params.DefaultProposerPolicy, g.Config.Istanbul.ProposerPolicy)
+	assert.Equal(t, params.DefaultSubGroupSize, g.Config.Istanbul.SubGroupSize)
+
+	g = New(Istanbul(&params.IstanbulConfig{Epoch: 30, ProposerPolicy: 2, SubGroupSize: 22}), Epoch(0))
+	assert.Equal(t, uint64(30), g.Config.Istanbul.Epoch)
+
+	g = New(Istanbul(&params.IstanbulConfig{Epoch: 30, ProposerPolicy: 0, SubGroupSize: 22}), Proposers(istanbul.WeightedRandom, 7))
+	assert.Equal(t, uint64(30), g.Config.Istanbul.Epoch)
+	assert.Equal(t, uint64(istanbul.WeightedRandom), g.Config.Istanbul.ProposerPolicy)
+	assert.Equal(t, uint64(7), g.Config.Istanbul.SubGroupSize)
+
+	// an unknown policy or an empty committee is rejected
+	for _, opt := range []Option{Proposers(istanbul.WeightedRandom+1, 7), Proposers(istanbul.Sticky, 0)} {
+		g = New(Istanbul(&params.IstanbulConfig{Epoch: 30, ProposerPolicy: 0, SubGroupSize: 22}), opt)
+		assert.Equal(t, &params.IstanbulConfig{Epoch: 30, ProposerPolicy: 0, SubGroupSize: 22}, g.Config.Istanbul)
+	}
+}
+
+func TestAllocTreasury(t *testing.T) {
+	validator := common.HexToAddress("0x1")
+	treasury := common.HexToAddress("0x2")
+	balance := new(big.Int).Exp(big.NewInt(10), big.NewInt(27), nil)
+
+	g := New(
+		Alloc([]common.Address{validator}, big.NewInt(1)),
+		AllocTreasury(treasury, balance),
+	)
+	assert.Equal(t, balance, g.Alloc[treasury].Balance)
+	assert.Equal(t, big.NewInt(1), g.Alloc[validator].Balance)
+	assert.Nil(t, g.Config.Governance)
+
+	g = New(AllocTreasury(treasury, big.NewInt(-1)))
+	_, ok := g.Alloc[treasury]
+	assert.False(t, ok)
+
+	// a genesis without an alloc gets one
+	g = &blockchain.Genesis{}
+	AllocTreasury(treasury, balance)(g)
+	assert.Equal(t, balance, g.Alloc[treasury].Balance)
+}
+
+func TestStakingAndProposerInterval(t *testing.T) {
+	// zero is rejected without installing the governance config
+	g := New(StakingInterval(0), ProposerInterval(0))
+	assert.Nil(t, g.Config.Governance)
+
+	g = New(Governance(params.GetDefaultGovernanceConfig()), StakingInterval(0), ProposerInterval(0))
+	assert.Equal(t, params.DefaultStakeUpdateInterval, g.Config.Governance.Reward.StakingUpdateInterval)
+	assert.Equal(t, params.DefaultProposerRefreshInterval, g.Config.Governance.Reward.ProposerUpdateInterval)
+
+	// valid intervals are set regardless of the order, even if they do not divide the defaults
+	for _, options := range [][]Option{
+		{StakingInterval(70), ProposerInterval(7)},
+		{ProposerInterval(7), StakingInterval(70)},
+	} {
+		g = New(options...)
+		assert.Equal(t, uint64(70), g.Config.Governance.Reward.StakingUpdateInterval)
+		assert.Equal(t, uint64(7), g.Config.Governance.Reward.ProposerUpdateInterval)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	options := []Option{
+		Validators(common.HexToAddress("0x1")),
+		Alloc([]common.Address{common.HexToAddress("0x1")}, big.NewInt(1)),
+		DeriveShaImpl(2),
+		Timestamp(1600000000),
+	}
+
+	g1 := New(options...)
+	g2 := New(options...)
+	assert.Equal(t, uint64(1600000000), g1.Timestamp)
+	assert.Equal(t, 2, g1.Config.DeriveShaImpl)
+
+	json1, err := json.Marshal(g1)
+	require.NoError(t, err)
+	json2, err := json.Marshal(g2)
+	require.NoError(t, err)
+	assert.Equal(t, json1, json2)
+
+	// the current time is used if the timestamp is not given
+	assert.NotZero(t, New().Timestamp)
+}
+
+func TestCoinbase(t *testing.T) {
+	addr := common.HexToAddress("0xc0ffee")
+	g := New(Coinbase(addr))
+	assert.Equal(t, addr, g.Coinbase)
+
+	// the coinbase is zero if not given
+	assert.Equal(t, common.Address{}, New().Coinbase)
+}
+
+func TestChainIDWithEIP155(t *testing.T) {
+	chainID := big.NewInt(1001)
+	g := New(ChainIDWithEIP155(chainID))
+	assert.Equal(t, chainID, g.Config.ChainID)
+
+	// the signers of the genesis block expect the same chain ID
+	assert.Equal(t, chainID, types.MakeSigner(g.Config, big.NewInt(0)).ChainID())
+
+	g = New(ChainIDWithEIP155(big.NewInt(0)))
+	assert.Equal(t, big.NewInt(2018), g.Config.ChainID)
+}
+
+func TestNetworkID(t *testing.T) {
+	g := New(NetworkID(1001))
+	assert.Equal(t, big.NewInt(1001), g.Config.ChainID)
+
+	g = New(NetworkID(0))
+	assert.Equal(t, big.NewInt(2018), g.Config.ChainID)
+}
+
+func TestKIP71Defaults(t *testing.T) {
+	g := New(KIP71Defaults())
+	kip71 := g.Config.Governance.KIP71
+	assert.Equal(t, uint64(25*params.Ston), kip71.LowerBoundBaseFee)
+	assert.Equal(t, uint64(750*params.Ston), kip71.UpperBoundBaseFee)
+	assert.Equal(t, uint64(30000000), kip71.GasTarget)
+	assert.Equal(t, uint64(60000000), kip71.MaxBlockGasUsedForBaseFee)
+	assert.Equal(t, uint64(20), kip71.BaseFeeDenominator)
+
+	// the defaults can be tweaked by the later options
+	g = New(KIP71Defaults(), ZeroGasPrice())
+	assert.Zero(t, g.Config.Governance.KIP71.LowerBoundBaseFee)
+	assert.Equal(t, uint64(30000000), g.Config.Governance.KIP71.GasTarget)
+}
+
+func TestMagmaAt(t *testing.T) {
+	g := New(MagmaAt(100, 25000000000))
+	assert.Equal(t, big.NewInt(100), g.Config.MagmaCompatibleBlock)
+	assert.Equal(t, uint64(25000000000), g.Config.UnitPrice)
+	assert.NotNil(t, g.Config.Governance.KIP71)
+	assert.NoError(t, g.Config.CheckConfigForkOrder())
+
+	// pre-London before the Magma block
+	assert.False(t, g.Config.IsLondonForkEnabled(big.NewInt(99)))
+	assert.False(t, g.Config.IsMagmaForkEnabled(big.NewInt(99)))
+	assert.True(t, g.Config.IsLondonForkEnabled(big.NewInt(100)))
+	assert.True(t, g.Config.IsMagmaForkEnabled(big.NewInt(100)))
+
+	// an earlier Istanbul block is kept
+	g = New(func(g *blockchain.Genesis) { g.Config.IstanbulCompatibleBlock = big.NewInt(10) }, MagmaAt(100, 1))
+	assert.Equal(t, big.NewInt(10), g.Config.IstanbulCompatibleBlock)
+	assert.NoError(t, g.Config.CheckConfigForkOrder())
+
+	// a later hardfork activated before the Magma block is rejected
+	g = New(func(g *blockchain.Genesis) { g.Config.KoreCompatibleBlock = big.NewInt(50) }, MagmaAt(100, 1))
+	assert.Nil(t, g.Config.MagmaCompatibleBlock)
+}
+
+func TestVerifyGenesisHash(t *testing.T) {
+	options := []Option{Timestamp(1700000000), DeriveShaImpl(2), Alloc([]common.Address{common.HexToAddress("0x1")}, big.NewInt(1))}
+	g := New(options...)
+	blockchain.InitDeriveSha(g.Config)
+	expected := g.ToBlock(common.Hash{}, nil).Hash()
+
+	// a matching hash passes, so the process is not terminated
+	g = New(append(options, VerifyGenesisHash(expected))...)
+	assert.NoError(t, verifyGenesisHash(g, expected))
+
+	// a wrong hash is detected
+	assert.ErrorIs(t, verifyGenesisHash(g, common.HexToHash("0x1234")), errGenesisHashMismatch)
+
+	// the hash depends on the options
+	assert.ErrorIs(t, verifyGenesisHash(New(append(options, Timestamp(1700000001))...), expected), errGenesisHashMismatch)
+
+	// the derive-sha implementation of the process is not changed
+	blockchain.InitDeriveSha(New().Config)
+	assert.NoError(t, verifyGenesisHash(g, expected))
+	assert.Equal(t, types.EmptyRootHashOriginal, types.EmptyRootHash(common.Big0))
+}
+
+func TestGovernanceData(t *testing.T) {
+	g := New(Istanbul(params.GetDefaultIstanbulConfig()), Governance(params.GetDefaultGovernanceConfig()))
+	raw := blockchain.SetGenesisGovernance(g)
+
+	g = New(GovernanceData(raw))
+	assert.Equal(t, raw, g.Governance)
+
+	// the bytes are copied
+	expected := common.CopyBytes(raw)
+	raw[0] ^= 0xff
+	assert.Equal(t, expected, g.Governance)
+
+	// the bytes round-trip through the genesis file and the genesis block
+	enc, err := json.Marshal(g)
+	require.NoError(t, err)
+	decoded := new(blockchain.Genesis)
+	require.NoError(t, json.Unmarshal(enc, decoded))
+	assert.Equal(t, expected, decoded.Governance)
+
+	blockchain.InitDeriveSha(decoded.Config)
+	assert.Equal(t, expected, decoded.ToBlock(common.Hash{}, nil).Header().Governance)
+}
+
+// cancunAt activates the hardforks up to Cancun at block n.
+func cancunAt(n int64) Option {
+	return func(genesis *blockchain.Genesis) {
+		MagmaAt(uint64(n), 25*params.Ston)(genesis)
+		genesis.Config.KoreCompatibleBlock = big.NewInt(n)
+		genesis.Config.ShanghaiCompatibleBlock = big.NewInt(n)
+		genesis.Config.CancunCompatibleBlock = big.NewInt(n)
+	}
+}
+
+func TestRandaoCompatibleBlock(t *testing.T) {
+	g := New(cancunAt(5), RandaoCompatibleBlock(big.NewInt(10)))
+	assert.Equal(t, big.NewInt(10), g.Config.RandaoCompatibleBlock)
+	assert.NoError(t, g.Config.CheckConfigForkOrder())
+
+	// Randao requires Cancun
+	g = New(RandaoCompatibleBlock(big.NewInt(10)))
+	assert.Nil(t, g.Config.RandaoCompatibleBlock)
+	g = New(cancunAt(20), RandaoCompatibleBlock(big.NewInt(10)))
+	assert.Nil(t, g.Config.RandaoCompatibleBlock)
+	g = New(cancunAt(5), RandaoCompatibleBlock(big.NewInt(-1)))
+	assert.Nil(t, g.Config.RandaoCompatibleBlock)
+}
+
+func TestAllocKip113Registry(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	owner := common.HexToAddress("0xbeef")
+	init := istcommon.GenerateKip113Init([]*ecdsa.PrivateKey{key}, owner)
+	proxy, logic := system.Kip113ProxyAddrMock, system.Kip113LogicAddrMock
+
+	for _, randao := range []int64{10, 0} {
+		g := New(cancunAt(0), RandaoCompatibleBlock(big.NewInt(randao)), AllocKip113Registry(proxy, logic, init))
+		assert.Equal(t, big.NewInt(randao), g.Config.RandaoCompatibleBlock)
+		assert.Equal(t, system.ERC1967ProxyCode, g.Alloc[proxy].Code)
+		assert.Equal(t, system.Kip113Code, g.Alloc[logic].Code)
+		require.NotNil(t, g.Config.RandaoRegistry)
+		assert.Equal(t, owner, g.Config.RandaoRegistry.Owner)
+		assert.Equal(t, proxy, g.Config.RandaoRegistry.Records[system.Kip113Name])
+
+		backend := backends.NewSimulatedBackend(g.Alloc)
+		infos, err := system.ReadKip113All(backend, proxy, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, init.Infos, infos)
+
+		// the registry is in the genesis only if Randao is activated at the genesis block,
+		// otherwise it is installed at the block before the fork block
+		if randao == 0 {
+			addr, err := system.ReadRegistryActiveAddr(backend, system.Kip113Name, common.Big0)
+			assert.NoError(t, err)
+			assert.Equal(t, proxy, addr)
+		} else {
+			assert.NotContains(t, g.Alloc, system.RegistryAddr)
+		}
+		backend.Close()
+	}
+}
+
+func TestRecommendedPruningMode(t *testing.T) {
+	g := New()
+	assert.Equal(t, Metadata{RecommendedPruningModeKey: "live"}, NewMetadata(g, RecommendedPruningMode("live")))
+
+	// an unknown mode is rejected
+	assert.Empty(t, NewMetadata(g, RecommendedPruningMode("fast")))
+}
+
+func TestTotalSupplyCap(t *testing.T) {
+	supplyCap := new(big.Int).Mul(big.NewInt(10_000_000_000), big.NewInt(params.KLAY))
+	g := New(Alloc([]common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}, big.NewInt(params.KLAY)))
+	metadata := NewMetadata(g, TotalSupplyCap(supplyCap))
+	assert.Equal(t, "10000000000000000000000000000", metadata[TotalSupplyCapKey])
+
+	recorded, ok := new(big.Int).SetString(metadata[TotalSupplyCapKey], 10)
+	require.True(t, ok)
+	assert.Equal(t, supplyCap, recorded)
+
+	// the cap must cover the genesis allocation
+	assert.Empty(t, NewMetadata(g, TotalSupplyCap(big.NewInt(params.KLAY))))
+	assert.Empty(t, NewMetadata(g, TotalSupplyCap(big.NewInt(0))))
+}
+
+func TestFeeBurn(t *testing.T) {
+	burnAddress := common.HexToAddress("0xdead")
+	g := New()
+	metadata := NewMetadata(g, FeeBurn(burnAddress, 50))
+	assert.Equal(t, Metadata{FeeBurnAddressKey: burnAddress.Hex(), FeeBurnRatioKey: "50"}, metadata)
+
+	// the metadata is saved next to the genesis file, which does not have it
+	dir := t.TempDir()
+	require.NoError(t, Save(dir, g))
+	require.NoError(t, SaveMetadata(dir, metadata))
+	raw, err := os.ReadFile(filepath.Join(dir, MetadataFileName))
+	require.NoError(t, err)
+	decoded := make(Metadata)
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, metadata, decoded)
+	raw, err = os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), FeeBurnAddressKey)
+
+	for _, ratio := range []int{-1, 101} {
+		assert.Empty(t, NewMetadata(g, FeeBurn(burnAddress, ratio)))
+	}
+}
+
+func TestAllocRegistry(t *testing.T) {
+	records := map[string]common.Address{
+		"AcmeContract": common.HexToAddress("0xaaaa"),
+		"TestContract": common.HexToAddress("0xcccc"),
+	}
+	g := New(AllocRegistry(records))
+	assert.Equal(t, system.RegistryCode, g.Alloc[system.RegistryAddr].Code)
+
+	backend := backends.NewSimulatedBackend(g.Alloc)
+	defer backend.Close()
+
+	for name, expected := range records {
+		addr, err := system.ReadRegistryActiveAddr(backend, name, common.Big0)
+		require.NoError(t, err)
+		assert.Equal(t, expected, addr)
+	}
+
+	addr, err := system.ReadRegistryActiveAddr(backend, "Unknown", common.Big0)
+	require.NoError(t, err)
+	assert.Equal(t, common.Address{}, addr)
+}
+
+func TestMinimumStake(t *testing.T) {
+	amount := big.NewInt(5000000)
+	g := New(MinimumStake(amount))
+	assert.Equal(t, amount, g.Config.Governance.Reward.MinimumStake)
+
+	g = New(MinimumStake(big.NewInt(0)))
+	assert.Equal(t, big.NewInt(0), g.Config.Governance.Reward.MinimumStake)
+
+	g = New(MinimumStake(big.NewInt(-1)))
+	assert.Nil(t, g.Config.Governance)
+}
+
+func TestBlockPeriod(t *testing.T) {
+	// clique
+	g := NewClique(BlockPeriod(5))
+	assert.Equal(t, uint64(5), g.Config.Clique.Period)
+	assert.Equal(t, params.DefaultEpoch, g.Config.Clique.Epoch)
+
+	g = NewClique(Clique(&params.CliqueConfig{Period: 1, Epoch: 30}), BlockPeriod(3))
+	assert.Equal(t, uint64(3), g.Config.Clique.Period)
+	assert.Equal(t, uint64(30), g.Config.Clique.Epoch)
+
+	// zero period is rejected as CliquePeriod does
+	g = NewClique(Clique(&params.CliqueConfig{Period: 1, Epoch: 30}), BlockPeriod(0))
+	assert.Equal(t, uint64(1), g.Config.Clique.Period)
+
+	// istanbul has no block period in genesis
+	istanbul := &params.IstanbulConfig{Epoch: 30, ProposerPolicy: 0, SubGroupSize: 22}
+	g = New(Istanbul(istanbul), BlockPeriod(3))
+	assert.Nil(t, g.Config.Clique)
+	assert.Equal(t, &params.IstanbulConfig{Epoch: 30, ProposerPolicy: 0, SubGroupSize: 22}, g.Config.Istanbul)
+}
+
+func TestZeroGasPrice(t *testing.T) {
+	g := New(UnitPrice(25000000000), ZeroGasPrice())
+	assert.Equal(t, uint64(0), g.Config.UnitPrice)
+	assert.Equal(t, uint64(0), g.Config.Governance.KIP71.LowerBoundBaseFee)
+	assert.Equal(t, uint64(0), g.Config.Governance.KIP71.UpperBoundBaseFee)
+
+	// the other KIP-71 parameters keep their defaults
+	assert.Equal(t, params.GetDefaultKIP71Config().GasTarget, g.Config.Governance.KIP71.GasTarget)
+
+	// the base fee of the genesis block is zero if magma is enabled from the genesis
+	g.Config.MagmaCompatibleBlock = big.NewInt(0)
+	block := g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+	assert.Equal(t, big.NewInt(0), block.Header().BaseFee)
+}
+
+func TestAllocMap(t *testing.T) {
+	var (
+		addr1 = common.HexToAddress("0x1")
+		addr2 = common.HexToAddress("0x2")
+		addr3 = common.HexToAddress("0x3")
+	)
+	g := New(
+		Alloc([]common.Address{addr1}, big.NewInt(100)),
+		AllocMap(map[common.Address]*big.Int{
+			addr1: big.NewInt(1),
+			addr2: big.NewInt(2),
+			addr3: big.NewInt(3),
+		}),
+	)
+	assert.Len(t, g.Alloc, 3)
+	// the balance of an already allocated address is summed up
+	assert.Equal(t, big.NewInt(101), g.Alloc[addr1].Balance)
+	assert.Equal(t, big.NewInt(2), g.Alloc[addr2].Balance)
+	assert.Equal(t, big.NewInt(3), g.Alloc[addr3].Balance)
+
+	// a negative balance rejects the whole map
+	g = New(
+		Alloc([]common.Address{addr1}, big.NewInt(100)),
+		AllocMap(map[common.Address]*big.Int{addr2: big.NewInt(2), addr3: big.NewInt(-3)}),
+	)
+	assert.Len(t, g.Alloc, 1)
+	assert.Equal(t, big.NewInt(100), g.Alloc[addr1].Balance)
+}
+
+func TestAllocValidators(t *testing.T) {
+	var (
+		val1     = common.HexToAddress("0x1")
+		val2     = common.HexToAddress("0x2")
+		other    = common.HexToAddress("0x3")
+		existing = common.HexToAddress("0x4")
+	)
+	g := New(
+		Alloc([]common.Address{existing}, big.NewInt(1000)),
+		AllocValidators([]common.Address{val1, val2}, big.NewInt(100), []common.Address{val2, other, existing}, big.NewInt(10)),
+	)
+	assert.Len(t, g.Alloc, 4)
+	assert.Equal(t, big.NewInt(100), g.Alloc[val1].Balance)
+	// an address in both groups receives both balances
+	assert.Equal(t, big.NewInt(110), g.Alloc[val2].Balance)
+	assert.Equal(t, big.NewInt(10), g.Alloc[other].Balance)
+	// an already allocated address is not clobbered
+	assert.Equal(t, big.NewInt(1010), g.Alloc[existing].Balance)
+
+	// a negative balance rejects both groups
+	g = New(
+		Alloc([]common.Address{existing}, big.NewInt(1000)),
+		AllocValidators([]common.Address{val1}, big.NewInt(100), []common.Address{other}, big.NewInt(-10)),
+	)
+	assert.Len(t, g.Alloc, 1)
+}
+
+func TestAllocVesting(t *testing.T) {
+	var (
+		beneficiary = common.HexToAddress("0xbeef")
+		amount      = big.NewInt(1000000)
+		addr        = VestingAddress(beneficiary)
+	)
+
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+
+	// the simulated backend starts at the timestamp 0
+	g := New(Timestamp(0), Alloc([]common.Address{sender}, big.NewInt(params.KLAY)), AllocVesting(beneficiary, amount, 100, 1000))
+	require.Contains(t, g.Alloc, addr)
+	assert.Equal(t, amount, g.Alloc[addr].Balance)
+
+	backend := backends.NewSimulatedBackend(g.Alloc)
+	defer backend.Close()
+
+	wallet, err := vesting.NewVesting(addr, backend)
+	require.NoError(t, err)
+	releasable := func() *big.Int {
+		amount, err := wallet.Releasable(nil)
+		require.NoError(t, err)
+		return amount
+	}
+	installed, err := wallet.Beneficiary(nil)
+	require.NoError(t, err)
+	assert.Equal(t, beneficiary, installed)
+
+	// nothing is releasable before the cliff
+	assert.Zero(t, releasable().Sign())
+
+	// the amount vests linearly after the cliff
+	require.NoError(t, backend.AdjustTime(300*time.Second))
+	backend.Commit()
+	now := backend.BlockChain().CurrentBlock().Time()
+	require.True(t, now.Uint64() >= 100 && now.Uint64() < 1000)
+	expected := new(big.Int).Div(new(big.Int).Mul(amount, now), big.NewInt(1000))
+	assert.Equal(t, expected, releasable())
+
+	// the whole amount is releasable after the duration
+	require.NoError(t, backend.AdjustTime(1000*time.Second))
+	backend.Commit()
+	assert.Equal(t, amount, releasable())
+
+	// release() transfers the releasable amount to the beneficiary
+	ctx := context.Background()
+	tx, err := wallet.Release(bind.NewKeyedTransactor(senderKey))
+	require.NoError(t, err)
+	backend.Commit()
+	receipt, err := backend.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	balance, err := backend.BalanceAt(ctx, beneficiary, nil)
+	require.NoError(t, err)
+	assert.Equal(t, amount, balance)
+	assert.Zero(t, releasable().Sign())
+
+	// a cliff longer than the duration is rejected
+	g = New(AllocVesting(beneficiary, amount, 1001, 1000))
+	assert.NotContains(t, g.Alloc, addr)
+}
+
+func TestAllocAllowlist(t *testing.T) {
+	adminKey, _ := crypto.GenerateKey()
+	admin := crypto.PubkeyToAddress(adminKey.PublicKey)
+	allowed, other := common.HexToAddress("0xaaaa"), common.HexToAddress("0xbbbb")
+	addr := AllowlistAddress(admin)
+
+	g := New(Alloc([]common.Address{admin}, big.NewInt(params.KLAY)), AllocAllowlist(admin, []common.Address{allowed}))
+	require.Contains(t, g.Alloc, addr)
+
+	backend := backends.NewSimulatedBackend(g.Alloc)
+	defer backend.Close()
+
+	list, err := allowlist.NewAllowlist(addr, backend)
+	require.NoError(t, err)
+	isAllowed := func(account common.Address) bool {
+		ok, err := list.IsAllowed(nil, account)
+		require.NoError(t, err)
+		return ok
+	}
+
+	assert.True(t, isAllowed(allowed))
+	assert.False(t, isAllowed(other))
+	installed, err := list.Admin(nil)
+	require.NoError(t, err)
+	assert.Equal(t, admin, installed)
+
+	// the admin can allow an account
+	ctx := context.Background()
+	tx, err := list.Allow(bind.NewKeyedTransactor(adminKey), other)
+	require.NoError(t, err)
+	backend.Commit()
+	receipt, err := backend.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	assert.True(t, isAllowed(other))
+
+	// a zero account is rejected
+	g = New(AllocAllowlist(admin, []common.Address{{}}))
+	assert.NotContains(t, g.Alloc, addr)
+}
+
+func TestAllocMultisigGovernance(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	owners := make([]common.Address, 3)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		owners[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	addr := MultisigAddress(owners, 2)
+
+	g := New(AllocMultisigGovernance(owners, 2))
+	require.Contains(t, g.Alloc, addr)
+	assert.Equal(t, common.FromHex(multisig.MultiSigBinRuntime), g.Alloc[addr].Code)
+	assert.Zero(t, g.Alloc[addr].Balance.Sign())
+	assert.Equal(t, addr, g.Config.Governance.GoverningNode)
+
+	// the multisig executes a call confirmed by as many owners as the threshold
+	recipient := common.HexToAddress("0xbeef")
+	g = New(
+		Alloc(owners, big.NewInt(params.KLAY)),
+		AllocMultisigGovernance(owners, 2),
+		AllocMap(map[common.Address]*big.Int{addr: big.NewInt(1000)}),
+	)
+	backend := backends.NewSimulatedBackend(g.Alloc)
+	defer backend.Close()
+
+	wallet, err := multisig.NewMultiSig(addr, backend)
+	require.NoError(t, err)
+	threshold, err := wallet.Threshold(nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), threshold)
+
+	// the installed contract is the same as the one deployed with the owners
+	ctx := context.Background()
+	deployed, _, _, err := multisig.DeployMultiSig(bind.NewKeyedTransactor(keys[0]), backend, owners, big.NewInt(2))
+	require.NoError(t, err)
+	backend.Commit()
+	code, err := backend.CodeAt(ctx, deployed, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, g.Alloc[addr].Code, code)
+	for key, value := range g.Alloc[addr].Storage {
+		stored, err := backend.StorageAt(ctx, deployed, key, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, value, common.BytesToHash(stored), key.Hex())
+	}
+
+	send := func(tx *types.Transaction, err error) uint {
+		require.NoError(t, err)
+		backend.Commit()
+		receipt, err := backend.TransactionReceipt(ctx, tx.Hash())
+		require.NoError(t, err)
+		return receipt.Status
+	}
+	transactor := func(key *ecdsa.PrivateKey) *bind.TransactOpts {
+		auth := bind.NewKeyedTransactor(key)
+		auth.GasLimit = 200000
+		return auth
+	}
+
+	hash, err := wallet.CallHash(nil, recipient, big.NewInt(1000), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.ReceiptStatusSuccessful, send(wallet.Confirm(transactor(keys[0]), hash)))
+	// an owner cannot confirm twice
+	assert.Equal(t, types.ReceiptStatusErrExecutionReverted, send(wallet.Confirm(transactor(keys[0]), hash)))
+	// one confirmation is below the threshold
+	assert.Equal(t, types.ReceiptStatusErrExecutionReverted, send(wallet.Execute(transactor(keys[0]), recipient, big.NewInt(1000), nil)))
+
+	assert.Equal(t, types.ReceiptStatusSuccessful, send(wallet.Confirm(transactor(keys[1]), hash)))
+	assert.Equal(t, types.ReceiptStatusSuccessful, send(wallet.Execute(transactor(keys[2]), recipient, big.NewInt(1000), nil)))
+	balance, err := backend.BalanceAt(ctx, recipient, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), balance)
+
+	// the executed call cannot be replayed since the nonce has increased
+	assert.Equal(t, types.ReceiptStatusErrExecutionReverted, send(wallet.Execute(transactor(keys[2]), recipient, big.NewInt(1000), nil)))
+
+	// an invalid threshold is rejected
+	g = New(AllocMultisigGovernance(owners, 4))
+	assert.NotContains(t, g.Alloc, MultisigAddress(owners, 4))
+	assert.Nil(t, g.Config.Governance)
+}
+
+func TestAllocServiceChainAnchor(t *testing.T) {
+	operatorKey, _ := crypto.GenerateKey()
+	operator := crypto.PubkeyToAddress(operatorKey.PublicKey)
+	addr := ServiceChainAnchorAddress(operator)
+
+	g := New(Alloc([]common.Address{operator}, big.NewInt(params.KLAY)), AllocServiceChainAnchor(operator))
+	require.Contains(t, g.Alloc, addr)
+
+	backend := backends.NewSimulatedBackend(g.Alloc)
+	defer backend.Close()
+
+	anchor, err := bridge.NewBridge(addr, backend)
+	require.NoError(t, err)
+
+	owner, err := anchor.Owner(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, operator, owner)
+	isOperator, err := anchor.Operators(nil, operator)
+	assert.NoError(t, err)
+	assert.True(t, isOperator)
+	operators, err := anchor.GetOperatorList(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{operator}, operators)
+	for voteType := uint8(0); voteType < anchorVoteTypes; voteType++ {
+		threshold, err := anchor.OperatorThresholds(nil, voteType)
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(1), threshold)
+	}
+	isRunning, err := anchor.IsRunning(nil)
+	assert.NoError(t, err)
+	assert.True(t, isRunning)
+
+	// the installed contract is the same as the one deployed by the operator
+	auth := bind.NewKeyedTransactor(operatorKey)
+	deployed, _, _, err := bridge.DeployBridge(auth, backend, false)
+	require.NoError(t, err)
+	backend.Commit()
+
+	ctx := context.Background()
+	code, err := backend.CodeAt(ctx, deployed, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, g.Alloc[addr].Code, code)
+	for key, value := range g.Alloc[addr].Storage {
+		stored, err := backend.StorageAt(ctx, deployed, key, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, value, common.BytesToHash(stored), key.Hex())
+	}
+
+	// the operator owns the contract
+	auth.Nonce = big.NewInt(1)
+	_, err = anchor.Start(auth, false)
+	require.NoError(t, err)
+	backend.Commit()
+	isRunning, err = anchor.IsRunning(nil)
+	assert.NoError(t, err)
+	assert.False(t, isRunning)
+
+	// the zero operator is rejected
+	g = New(AllocServiceChainAnchor(common.Address{}))
+	assert.NotContains(t, g.Alloc, ServiceChainAnchorAddress(common.Address{}))
+}
+
+func TestRewardAddresses(t *testing.T) {
+	var (
+		addrs  = []common.Address{common.HexToAddress("0x1")}
+		kgf    = common.HexToAddress("0xaaaa")
+		kir    = common.HexToAddress("0xbbbb")
+		abAddr = common.HexToAddress(contract.AddressBookContractAddress)
+	)
+
+	readBack := func(g *blockchain.Genesis) (common.Address, common.Address) {
+		backend := backends.NewSimulatedBackend(g.Alloc)
+		defer backend.Close()
+
+		caller, err := contract.NewAddressBookCaller(abAddr, backend)
+		require.NoError(t, err)
+		poc, err := caller.PocContractAddress(nil)
+		require.NoError(t, err)
+		kirAddr, err := caller.KirContractAddress(nil)
+		require.NoError(t, err)
+		return poc, kirAddr
+	}
+
+	for _, alloc := range []Option{
+		AllocWithCypressContract(addrs, big.NewInt(1)),
+		AllocWithBaobabContract(addrs, big.NewInt(1)),
+		AllocWithPrecypressContract(addrs, big.NewInt(1)),
+		AllocWithPrebaobabContract(addrs, big.NewInt(1)),
+	} {
+		poc, kirAddr := readBack(New(alloc, RewardAddresses(kgf, kir)))
+		assert.Equal(t, kgf, poc)
+		assert.Equal(t, kir, kirAddr)
+
+		poc, kirAddr = readBack(New(alloc, AddressBookMock(), RewardAddressesKFF(kgf, kir)))
+		assert.Equal(t, kgf, poc)
+		assert.Equal(t, kir, kirAddr)
+	}
+
+	// zero addresses are rejected
+	g := New(AllocWithBaobabContract(addrs, big.NewInt(1)), RewardAddresses(kgf, common.Address{}))
+	assert.Empty(t, g.Alloc[abAddr].Storage)
+}
+
+func TestAllocAddressBookUnactivated(t *testing.T) {
+	admins := []common.Address{common.HexToAddress("0xaaaa"), common.HexToAddress("0xbbbb")}
+	g := New(
+		Alloc([]common.Address{common.HexToAddress("0x1")}, big.NewInt(1)),
+		AllocAddressBookUnactivated(admins, 2),
+	)
+	assert.Len(t, g.Alloc, 2)
+
+	backend := backends.NewSimulatedBackend(g.Alloc)
+	defer backend.Close()
+
+	caller, err := contract.NewAddressBookCaller(common.HexToAddress(contract.AddressBookContractAddress), backend)
+	require.NoError(t, err)
+
+	activated, err := caller.IsActivated(nil)
+	require.NoError(t, err)
+	assert.False(t, activated)
+
+	constructed, err := caller.IsConstructed(nil)
+	require.NoError(t, err)
+	assert.True(t, constructed)
+
+	adminList, requirement, err := caller.GetState(nil)
+	require.NoError(t, err)
+	assert.Equal(t, admins, adminList)
+	assert.Equal(t, big.NewInt(2), requirement)
+
+	// invalid requirements and duplicated admins are rejected
+	for _, opt := range []Option{
+		AllocAddressBookUnactivated(admins, 0),
+		AllocAddressBookUnactivated(admins, 3),
+		AllocAddressBookUnactivated([]common.Address{admins[0], admins[0]}, 1),
+	} {
+		g = New(Alloc([]common.Address{common.HexToAddress("0x1")}, big.NewInt(1)), opt)
+		assert.Len(t, g.Alloc, 1)
+	}
+}
+
+func TestGenesisNumber(t *testing.T) {
+	g := New(GenesisNumber(big.NewInt(100)))
+	assert.Equal(t, uint64(100), g.Number)
+
+	block := g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+	assert.Equal(t, uint64(100), block.NumberU64())
+	assert.Nil(t, block.Header().BaseFee)
+
+	// the hardforks activated at or before the genesis number are in effect at the genesis block
+	for _, magma := range []uint64{50, 100} {
+		g = New(GenesisNumber(big.NewInt(100)), MagmaAt(magma, 1))
+		block = g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+		assert.Equal(t, new(big.Int).SetUint64(g.Config.Governance.KIP71.LowerBoundBaseFee), block.Header().BaseFee)
+	}
+	g = New(GenesisNumber(big.NewInt(100)), MagmaAt(101, 1))
+	block = g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+	assert.Nil(t, block.Header().BaseFee)
+
+	// the genesis number does not move the hardfork blocks
+	assert.Equal(t, big.NewInt(101), g.Config.MagmaCompatibleBlock)
+
+	// a nonzero genesis cannot be committed
+	_, err := g.Commit(common.Hash{}, database.NewMemoryDBManager())
+	assert.Error(t, err)
+
+	// invalid numbers are rejected
+	g = New(GenesisNumber(big.NewInt(-1)))
+	assert.Equal(t, uint64(0), g.Number)
+	g = New(GenesisNumber(new(big.Int).Lsh(common.Big1, 64)))
+	assert.Equal(t, uint64(0), g.Number)
+}
+
+func TestDifficulty(t *testing.T) {
+	g := New(Difficulty(big.NewInt(131072)))
+	assert.Equal(t, big.NewInt(131072), g.BlockScore)
+
+	block := g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+	assert.Equal(t, big.NewInt(131072), block.BlockScore())
+
+	// non-positive difficulties are rejected
+	g = New(Difficulty(big.NewInt(0)))
+	assert.Equal(t, big.NewInt(InitBlockScore), g.BlockScore)
+}
+
+func TestMixHash(t *testing.T) {
+	mixHash := common.HexToHash("0x1234")
+	g := New(MixHash(mixHash))
+	assert.Equal(t, mixHash, g.MixHash)
+
+	// the mix hash is in the header only if randao is enabled at the genesis block
+	block := g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+	assert.Nil(t, block.Header().MixHash)
+
+	g.Config.MagmaCompatibleBlock = big.NewInt(0)
+	g.Config.KoreCompatibleBlock = big.NewInt(0)
+	g.Config.ShanghaiCompatibleBlock = big.NewInt(0)
+	g.Config.CancunCompatibleBlock = big.NewInt(0)
+	g.Config.RandaoCompatibleBlock = big.NewInt(0)
+	block = g.ToBlock(common.Hash{}, database.NewMemoryDBManager())
+	assert.Equal(t, mixHash.Bytes(), block.Header().MixHash)
+}
+
+func TestDeferredTxFee(t *testing.T) {
+	g := New(DeferredTxFee(true))
+	assert.True(t, g.Config.Governance.Reward.DeferredTxFee)
+
+	g = New(DeferredTxFee(true), DeferredTxFee(false))
+	assert.False(t, g.Config.Governance.Reward.DeferredTxFee)
+
+	// the other reward parameters keep their defaults
+	assert.Equal(t, params.GetDefaultRewardConfig().MintingAmount, g.Config.Governance.Reward.MintingAmount)
+
+	// the governance config is not created if unused
+	assert.Nil(t, New().Config.Governance)
+}
+
+func TestCliquePeriodAndEpoch(t *testing.T) {
+	defaults := params.GetDefaultCliqueConfig()
+
+	g := NewClique(CliquePeriod(5))
+	assert.Equal(t, uint64(5), g.Config.Clique.Period)
+	assert.Equal(t, defaults.Epoch, g.Config.Clique.Epoch)
+
+	g = NewClique(CliqueEpoch(100))
+	assert.Equal(t, defaults.Period, g.Config.Clique.Period)
+	assert.Equal(t, uint64(100), g.Config.Clique.Epoch)
+
+	// each option preserves the value set by the other
+	g = NewClique(CliqueEpoch(100), CliquePeriod(5))
+	assert.Equal(t, &params.CliqueConfig{Period: 5, Epoch: 100}, g.Config.Clique)
+	g = NewClique(CliquePeriod(5), CliqueEpoch(100))
+	assert.Equal(t, &params.CliqueConfig{Period: 5, Epoch: 100}, g.Config.Clique)
+
+	// zero period is rejected
+	g = NewClique(CliqueEpoch(100), CliquePeriod(0))
+	assert.Equal(t, &params.CliqueConfig{Period: defaults.Period, Epoch: 100}, g.Config.Clique)
+}
+
+func TestGenesisTag(t *testing.T) {
+	signers := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}
+	g := NewClique(ValidatorsOfClique(signers...), Clique(params.GetDefaultCliqueConfig()), GenesisTag("testnet-v1"))
+
+	tag := strings.TrimRight(string(g.ExtraData[:clique.ExtraVanity]), "\x00")
+	assert.Equal(t, "testnet-v1", tag)
+
+	// the signers are kept
+	expected := NewClique(ValidatorsOfClique(signers...)).ExtraData
+	assert.Equal(t, expected[clique.ExtraVanity:], g.ExtraData[clique.ExtraVanity:])
+
+	// too long tags and istanbul are rejected
+	g = NewClique(ValidatorsOfClique(signers...), Clique(params.GetDefaultCliqueConfig()), GenesisTag(strings.Repeat("a", 33)))
+	assert.Equal(t, expected, g.ExtraData)
+	g = New(Validators(signers...), GenesisTag("testnet-v1"))
+	assert.Equal(t, New(Validators(signers...)).ExtraData, g.ExtraData)
+}
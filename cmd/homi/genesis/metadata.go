@@ -0,0 +1,124 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package genesis
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/common"
+)
+
+// MetadataFileName is the name of the file SaveMetadata writes next to genesis.json.
+const MetadataFileName = "genesis-metadata.json"
+
+// Metadata holds the hints for the tools setting up a network. The nodes do not read it and
+// the genesis block does not depend on it, so it is kept out of genesis.json.
+type Metadata map[string]string
+
+// MetadataOption records a hint about the given genesis in the metadata.
+type MetadataOption func(genesis *blockchain.Genesis, metadata Metadata)
+
+// NewMetadata returns the metadata recorded by the options about the given genesis,
+// which must be built before so that the options can check the hints against it.
+func NewMetadata(genesis *blockchain.Genesis, options ...MetadataOption) Metadata {
+	metadata := make(Metadata)
+	for _, opt := range options {
+		opt(genesis, metadata)
+	}
+	return metadata
+}
+
+func SaveMetadata(dataDir string, metadata Metadata) error {
+	filePath := filepath.Join(dataDir, MetadataFileName)
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filePath, raw, 0o600)
+}
+
+// RecommendedPruningModeKey is the key of the metadata set by RecommendedPruningMode.
+const RecommendedPruningModeKey = "recommendedPruningMode"
+
+// RecommendedPruningMode records the pruning mode recommended for the nodes of the network in the
+// metadata, which tools can read to configure the nodes. The mode is "full" or "archive" as the
+// --gcmode flag takes, or "live" for --state.live-pruning. It is only a hint; the nodes are still
+// configured by their flags.
+func RecommendedPruningMode(mode string) MetadataOption {
+	return func(genesis *blockchain.Genesis, metadata Metadata) {
+		switch mode {
+		case "full", "archive", "live":
+		default:
+			logger.Error("Pruning mode must be one of full, archive and live", "mode", mode)
+			return
+		}
+		metadata[RecommendedPruningModeKey] = mode
+	}
+}
+
+// TotalSupplyCapKey is the key of the metadata set by TotalSupplyCap.
+const TotalSupplyCapKey = "totalSupplyCap"
+
+// TotalSupplyCap records the cap of the total supply in peb in the metadata as a decimal string.
+// The reward config has no cap, so the nodes keep minting regardless of it; it is a record for the
+// tools monitoring the supply. The cap must not be lower than the total balance allocated at the
+// genesis block.
+func TotalSupplyCap(supplyCap *big.Int) MetadataOption {
+	return func(genesis *blockchain.Genesis, metadata Metadata) {
+		if supplyCap == nil || supplyCap.Sign() <= 0 {
+			logger.Error("Total supply cap must be positive", "cap", supplyCap)
+			return
+		}
+		allocated := new(big.Int)
+		for _, account := range genesis.Alloc {
+			if account.Balance != nil {
+				allocated.Add(allocated, account.Balance)
+			}
+		}
+		if supplyCap.Cmp(allocated) < 0 {
+			logger.Error("Total supply cap is lower than the genesis allocation", "cap", supplyCap, "allocated", allocated)
+			return
+		}
+		metadata[TotalSupplyCapKey] = supplyCap.String()
+	}
+}
+
+// Keys of the metadata set by FeeBurn.
+const (
+	FeeBurnAddressKey = "feeBurnAddress"
+	FeeBurnRatioKey   = "feeBurnRatio"
+)
+
+// FeeBurn records the address receiving the burnt fees and the percentage of the fees to burn
+// in the metadata. The reward config has no burn fields since the nodes burn the fees as the
+// hardforks define, so it is a record for the tools of deflationary test chains.
+func FeeBurn(burnAddress common.Address, ratioPercent int) MetadataOption {
+	return func(genesis *blockchain.Genesis, metadata Metadata) {
+		if ratioPercent < 0 || ratioPercent > 100 {
+			logger.Error("Fee burn ratio must be between 0 and 100", "ratio", ratioPercent)
+			return
+		}
+		metadata[FeeBurnAddressKey] = burnAddress.Hex()
+		metadata[FeeBurnRatioKey] = strconv.Itoa(ratioPercent)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package genesis
+
+import (
+	"math/big"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+// The storage layout of contracts/multisig/MultiSig.sol.
+const (
+	multisigThresholdSlot     = 0
+	multisigNonceSlot         = 1
+	multisigIsOwnerSlot       = 2 // isOwner[owner] at keccak256(owner . 2)
+	multisigConfirmationsSlot = 3 // confirmations[hash] at keccak256(hash . 3)
+	multisigConfirmedSlot     = 4 // confirmed[hash][owner] at keccak256(owner . keccak256(hash . 4))
+)
+
+// MultisigAddress returns the address of the multisig contract of the owners and the threshold
+// installed by AllocMultisigGovernance.
+func MultisigAddress(owners []common.Address, threshold int) common.Address {
+	data := []byte("multisig")
+	for _, owner := range owners {
+		data = append(data, owner.Bytes()...)
+	}
+	data = append(data, common.BigToHash(big.NewInt(int64(threshold))).Bytes()...)
+	return common.BytesToAddress(crypto.Keccak256(data))
+}
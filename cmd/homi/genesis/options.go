@@ -18,13 +18,23 @@
 package genesis
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 
 	"github.com/klaytn/klaytn/blockchain/system"
+	"github.com/klaytn/klaytn/blockchain/types/derivesha"
 	"github.com/klaytn/klaytn/cmd/homi/extra"
 	"github.com/klaytn/klaytn/consensus/clique"
+	"github.com/klaytn/klaytn/consensus/istanbul"
+	"github.com/klaytn/klaytn/contracts/allowlist"
+	"github.com/klaytn/klaytn/contracts/bridge"
+	"github.com/klaytn/klaytn/contracts/multisig"
 	"github.com/klaytn/klaytn/contracts/reward/contract"
+	"github.com/klaytn/klaytn/contracts/vesting"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/log"
 	"github.com/klaytn/klaytn/params"
 
@@ -37,6 +47,8 @@ type Option func(*blockchain.Genesis)
 
 var logger = log.NewModuleLogger(log.CMDIstanbul)
 
+var errGenesisHashMismatch = errors.New("genesis hash mismatch")
+
 func Validators(addrs ...common.Address) Option {
 	return func(genesis *blockchain.Genesis) {
 		extraData, err := extra.Encode("0x00", addrs)
@@ -57,6 +69,28 @@ func ValidatorsOfClique(signers ...common.Address) Option {
 	}
 }
 
+// GenesisTag writes the tag into the vanity of the clique extra data for provenance.
+// Istanbul has no vanity in its extra data, so the tag is written only if clique is configured.
+// It must be applied after ValidatorsOfClique and Clique since ValidatorsOfClique replaces the extra data.
+func GenesisTag(tag string) Option {
+	return func(genesis *blockchain.Genesis) {
+		if genesis.Config.Clique == nil {
+			logger.Error("Genesis tag can be written only for clique")
+			return
+		}
+		if len(tag) > clique.ExtraVanity {
+			logger.Error("Genesis tag is too long", "length", len(tag), "max", clique.ExtraVanity)
+			return
+		}
+		if len(genesis.ExtraData) < clique.ExtraVanity+clique.ExtraSeal {
+			genesis.ExtraData = make([]byte, clique.ExtraVanity+clique.ExtraSeal)
+		}
+		vanity := make([]byte, clique.ExtraVanity)
+		copy(vanity, tag)
+		copy(genesis.ExtraData, vanity)
+	}
+}
+
 func makeGenesisAccount(addrs []common.Address, balance *big.Int) map[common.Address]blockchain.GenesisAccount {
 	alloc := make(map[common.Address]blockchain.GenesisAccount)
 	for _, addr := range addrs {
@@ -124,6 +158,205 @@ func AllocWithBaobabContract(addrs []common.Address, balance *big.Int) Option {
 	}
 }
 
+// AllocTreasury funds the treasury account with the given balance.
+// RewardConfig has no treasury field, so the treasury is only registered in the alloc;
+// it must be applied after Alloc since Alloc replaces the whole alloc.
+func AllocTreasury(addr common.Address, balance *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if balance == nil || balance.Sign() < 0 {
+			logger.Error("Treasury balance must be non-negative", "balance", balance)
+			return
+		}
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		account := genesis.Alloc[addr]
+		account.Balance = new(big.Int).Set(balance)
+		genesis.Alloc[addr] = account
+	}
+}
+
+// AllocMap merges the given balances into the alloc. If an address is already allocated,
+// the given balance is added to its balance instead of replacing it, and its code and storage are kept.
+// It must be applied after Alloc since Alloc replaces the whole alloc.
+func AllocMap(balances map[common.Address]*big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		for addr, balance := range balances {
+			if balance == nil || balance.Sign() < 0 {
+				logger.Error("Balance must be non-negative", "addr", addr, "balance", balance)
+				return
+			}
+		}
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		for addr, balance := range balances {
+			account := genesis.Alloc[addr]
+			if account.Balance == nil {
+				account.Balance = new(big.Int)
+			}
+			account.Balance = new(big.Int).Add(account.Balance, balance)
+			genesis.Alloc[addr] = account
+		}
+	}
+}
+
+// AllocValidators funds the validators and the other accounts with their own balances in one call.
+// The balances are merged into the alloc like AllocMap, so an address in both groups receives
+// both balances and the accounts already allocated keep their code and storage.
+func AllocValidators(validators []common.Address, validatorBalance *big.Int, others []common.Address, otherBalance *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if validatorBalance == nil || validatorBalance.Sign() < 0 || otherBalance == nil || otherBalance.Sign() < 0 {
+			logger.Error("Balance must be non-negative", "validatorBalance", validatorBalance, "otherBalance", otherBalance)
+			return
+		}
+		balances := make(map[common.Address]*big.Int)
+		for _, addr := range validators {
+			balances[addr] = validatorBalance
+		}
+		for _, addr := range others {
+			if balance, ok := balances[addr]; ok {
+				balances[addr] = new(big.Int).Add(balance, otherBalance)
+			} else {
+				balances[addr] = otherBalance
+			}
+		}
+		AllocMap(balances)(genesis)
+	}
+}
+
+// AllocVesting installs the vesting contract of contracts/vesting at VestingAddress(beneficiary) funded with the amount.
+// The amount vests linearly over the duration in seconds from the genesis timestamp, and nothing
+// can be released before the cliff. It must be applied after Timestamp since the vesting starts
+// at the genesis timestamp, and after Alloc since Alloc replaces the whole alloc.
+func AllocVesting(beneficiary common.Address, amount *big.Int, cliff, duration uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if amount == nil || amount.Sign() < 0 {
+			logger.Error("Vesting amount must be non-negative", "amount", amount)
+			return
+		}
+		if cliff > duration {
+			logger.Error("Vesting cliff must not exceed the duration", "cliff", cliff, "duration", duration)
+			return
+		}
+		addr := VestingAddress(beneficiary)
+		if _, ok := genesis.Alloc[addr]; ok {
+			logger.Error("Vesting contract is already allocated", "beneficiary", beneficiary, "addr", addr)
+			return
+		}
+
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		genesis.Alloc[addr] = blockchain.GenesisAccount{
+			Code: common.FromHex(vesting.VestingBinRuntime),
+			Storage: map[common.Hash]common.Hash{
+				common.BigToHash(big.NewInt(vestingBeneficiarySlot)): beneficiary.Hash(),
+				common.BigToHash(big.NewInt(vestingStartSlot)):       common.BigToHash(new(big.Int).SetUint64(genesis.Timestamp)),
+				common.BigToHash(big.NewInt(vestingCliffSlot)):       common.BigToHash(new(big.Int).SetUint64(cliff)),
+				common.BigToHash(big.NewInt(vestingDurationSlot)):    common.BigToHash(new(big.Int).SetUint64(duration)),
+				common.BigToHash(big.NewInt(vestingAmountSlot)):      common.BigToHash(amount),
+			},
+			Balance: new(big.Int).Set(amount),
+		}
+	}
+}
+
+// AllocMultisigGovernance installs the multisig contract of contracts/multisig for the owners at
+// MultisigAddress(owners, threshold) and sets it as the governing node. The contract is not funded.
+// It must be applied after Governance since Governance replaces the whole governance config.
+func AllocMultisigGovernance(owners []common.Address, threshold int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if threshold <= 0 || threshold > len(owners) {
+			logger.Error("Threshold must be between 1 and the number of owners", "threshold", threshold, "owners", len(owners))
+			return
+		}
+
+		storage := map[common.Hash]common.Hash{
+			common.BigToHash(big.NewInt(multisigThresholdSlot)): common.BigToHash(big.NewInt(int64(threshold))),
+		}
+		isOwnerSlot := common.BigToHash(big.NewInt(multisigIsOwnerSlot))
+		for _, owner := range owners {
+			isOwnerKey := crypto.Keccak256Hash(owner.Hash().Bytes(), isOwnerSlot.Bytes())
+			if common.EmptyAddress(owner) || storage[isOwnerKey] != (common.Hash{}) {
+				logger.Error("Owners must be non-zero and unique", "owner", owner)
+				return
+			}
+			storage[isOwnerKey] = common.BigToHash(common.Big1)
+		}
+
+		addr := MultisigAddress(owners, threshold)
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		genesis.Alloc[addr] = blockchain.GenesisAccount{
+			Code:    common.FromHex(multisig.MultiSigBinRuntime),
+			Storage: storage,
+			Balance: big.NewInt(0),
+		}
+		ensureGovernance(genesis).GoverningNode = addr
+	}
+}
+
+// AllocServiceChainAnchor installs the anchoring bridge contract of contracts/bridge at
+// ServiceChainAnchorAddress(operator) as if the operator deployed it, so that the operator is
+// its owner and sole operator. It must be applied after Alloc since Alloc replaces the whole alloc.
+func AllocServiceChainAnchor(operator common.Address) Option {
+	return func(genesis *blockchain.Genesis) {
+		if common.EmptyAddress(operator) {
+			logger.Error("Anchoring operator must be non-zero")
+			return
+		}
+		addr := ServiceChainAnchorAddress(operator)
+		if _, ok := genesis.Alloc[addr]; ok {
+			logger.Error("Anchoring contract is already allocated", "operator", operator, "addr", addr)
+			return
+		}
+
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		genesis.Alloc[addr] = blockchain.GenesisAccount{
+			Code:    common.FromHex(bridge.BridgeBinRuntime),
+			Storage: anchorStorage(operator),
+			Balance: big.NewInt(0),
+		}
+	}
+}
+
+// AllocAllowlist installs the allowlist contract of contracts/allowlist at AllowlistAddress(admin)
+// which allows the accounts, and whose admin can allow or disallow accounts later.
+// The contract is not funded.
+func AllocAllowlist(admin common.Address, allowed []common.Address) Option {
+	return func(genesis *blockchain.Genesis) {
+		if common.EmptyAddress(admin) {
+			logger.Error("Allowlist admin must be non-zero")
+			return
+		}
+
+		storage := map[common.Hash]common.Hash{
+			common.BigToHash(big.NewInt(allowlistAdminSlot)): admin.Hash(),
+		}
+		isAllowedSlot := common.BigToHash(big.NewInt(allowlistIsAllowedSlot))
+		for _, account := range allowed {
+			if common.EmptyAddress(account) {
+				logger.Error("Allowed accounts must be non-zero")
+				return
+			}
+			storage[crypto.Keccak256Hash(account.Hash().Bytes(), isAllowedSlot.Bytes())] = common.BigToHash(common.Big1)
+		}
+
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		genesis.Alloc[AllowlistAddress(admin)] = blockchain.GenesisAccount{
+			Code:    common.FromHex(allowlist.AllowlistBinRuntime),
+			Storage: storage,
+			Balance: big.NewInt(0),
+		}
+	}
+}
+
 // Patch the hardcoded line in AddressBook.sol:constructContract().
 func PatchAddressBook(addr common.Address) Option {
 	return func(genesis *blockchain.Genesis) {
@@ -171,6 +404,91 @@ func AddressBookMock() Option {
 	}
 }
 
+// RewardAddresses registers the KGF (PoC) and KIR contract addresses in the AddressBook
+// allocated at the genesis block, so that the AddressBook can be activated without updating them.
+// RewardConfig has no field for the reward addresses since the reward distributor reads them
+// from the AddressBook. It must be applied after the AddressBook is allocated.
+func RewardAddresses(kgf, kir common.Address) Option {
+	return func(genesis *blockchain.Genesis) {
+		if common.EmptyAddress(kgf) || common.EmptyAddress(kir) {
+			logger.Error("Reward addresses must not be zero", "kgf", kgf, "kir", kir)
+			return
+		}
+
+		contractAddr := common.HexToAddress(contract.AddressBookContractAddress)
+		contractAccount, ok := genesis.Alloc[contractAddr]
+		if !ok {
+			logger.Error("No AddressBook to register the reward addresses")
+			return
+		}
+
+		// The storage slots of pocContractAddress and kirContractAddress
+		pocSlot, kirSlot := common.BigToHash(big.NewInt(5)), common.BigToHash(big.NewInt(6))
+		if bytes.Equal(contractAccount.Code, common.FromHex(contract.AddressBookMockBinRuntime)) {
+			pocSlot, kirSlot = common.BigToHash(big.NewInt(2)), common.BigToHash(big.NewInt(3))
+		}
+
+		storage := make(map[common.Hash]common.Hash, len(contractAccount.Storage)+2)
+		for k, v := range contractAccount.Storage {
+			storage[k] = v
+		}
+		storage[pocSlot] = common.BytesToHash(kgf.Bytes())
+		storage[kirSlot] = common.BytesToHash(kir.Bytes())
+		contractAccount.Storage = storage
+		genesis.Alloc[contractAddr] = contractAccount
+	}
+}
+
+// RewardAddressesKFF registers the KFF and KCF addresses, which replace KGF and KIR since the Kore hardfork.
+// They are stored in the same AddressBook slots as the KGF and KIR addresses.
+func RewardAddressesKFF(kff, kcf common.Address) Option {
+	return RewardAddresses(kff, kcf)
+}
+
+// AllocAddressBookUnactivated installs the AddressBook which is constructed with the given admins
+// and requirement but not activated yet, as if constructContract had been called after the genesis block.
+// It must be applied after Alloc since Alloc replaces the whole alloc.
+func AllocAddressBookUnactivated(admins []common.Address, requirement uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if requirement == 0 || requirement > uint64(len(admins)) {
+			logger.Error("Requirement must be between 1 and the number of admins", "requirement", requirement, "admins", len(admins))
+			return
+		}
+
+		// The storage layout of AddressBook.sol
+		var (
+			adminListSlot   = common.BigToHash(big.NewInt(0))
+			requirementSlot = common.BigToHash(big.NewInt(1))
+			isAdminSlot     = common.BigToHash(big.NewInt(2))
+			flagsSlot       = common.BigToHash(big.NewInt(12)) // isActivated at byte 0, isConstructed at byte 1
+		)
+		storage := map[common.Hash]common.Hash{
+			adminListSlot:   common.BigToHash(big.NewInt(int64(len(admins)))),
+			requirementSlot: common.BigToHash(new(big.Int).SetUint64(requirement)),
+			flagsSlot:       common.BigToHash(big.NewInt(0x0100)),
+		}
+		adminListBase := new(big.Int).SetBytes(crypto.Keccak256(adminListSlot.Bytes()))
+		for i, admin := range admins {
+			isAdminKey := crypto.Keccak256Hash(common.BytesToHash(admin.Bytes()).Bytes(), isAdminSlot.Bytes())
+			if common.EmptyAddress(admin) || storage[isAdminKey] != (common.Hash{}) {
+				logger.Error("Admins must be non-zero and unique", "admin", admin)
+				return
+			}
+			storage[isAdminKey] = common.BigToHash(common.Big1)
+			storage[common.BigToHash(new(big.Int).Add(adminListBase, big.NewInt(int64(i))))] = common.BytesToHash(admin.Bytes())
+		}
+
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		genesis.Alloc[common.HexToAddress(contract.AddressBookContractAddress)] = blockchain.GenesisAccount{
+			Code:    common.FromHex(CypressAddressBookBin),
+			Storage: storage,
+			Balance: big.NewInt(0),
+		}
+	}
+}
+
 func AllocateRegistry(storage map[common.Hash]common.Hash) Option {
 	return func(genesis *blockchain.Genesis) {
 		registryCode := system.RegistryCode
@@ -184,6 +502,12 @@ func AllocateRegistry(storage map[common.Hash]common.Hash) Option {
 	}
 }
 
+// AllocRegistry installs the KIP-149 registry with the given name to address records.
+// The records are active from the genesis block and the registry has no owner.
+func AllocRegistry(records map[string]common.Address) Option {
+	return AllocateRegistry(system.AllocRegistry(&params.RegistryConfig{Records: records}))
+}
+
 func RegistryMock() Option {
 	return func(genesis *blockchain.Genesis) {
 		registryMockCode := system.RegistryMockCode
@@ -219,6 +543,35 @@ func AllocateKip113(kip113ProxyAddr, kip113LogicAddr common.Address, storage map
 	}
 }
 
+// AllocKip113Registry installs the KIP-113 BLS public key registry behind a proxy, initialized with
+// the BLS public keys and the owner, and records the proxy in the registry used by the Randao hardfork.
+// The registry is installed at the block before RandaoCompatibleBlock from the chain config, or in
+// the genesis alloc if the Randao hardfork is activated at the genesis block.
+// It must be applied after RandaoCompatibleBlock.
+func AllocKip113Registry(kip113ProxyAddr, kip113LogicAddr common.Address, init system.AllocKip113Init) Option {
+	return func(genesis *blockchain.Genesis) {
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(blockchain.GenesisAlloc)
+		}
+		storage := system.MergeStorage(system.AllocProxy(kip113LogicAddr), system.AllocKip113(init))
+		AllocateKip113(kip113ProxyAddr, kip113LogicAddr, storage)(genesis)
+
+		registry := genesis.Config.RandaoRegistry
+		if registry == nil {
+			registry = &params.RegistryConfig{Owner: init.Owner}
+			genesis.Config.RandaoRegistry = registry
+		}
+		if registry.Records == nil {
+			registry.Records = make(map[string]common.Address)
+		}
+		registry.Records[system.Kip113Name] = kip113ProxyAddr
+
+		if randao := genesis.Config.RandaoCompatibleBlock; randao != nil && randao.Sign() == 0 {
+			AllocateRegistry(system.AllocRegistry(registry))(genesis)
+		}
+	}
+}
+
 func Kip113Mock(kip113LogicAddr common.Address) Option {
 	return func(genesis *blockchain.Genesis) {
 		kip113MockCode := system.Kip113MockCode
@@ -241,12 +594,121 @@ func ChainID(chainID *big.Int) Option {
 	}
 }
 
+// ChainIDWithEIP155 sets the chain ID used for EIP-155 replay protection.
+// Klaytn has no EIP155Block since its signers apply EIP-155 from the genesis block,
+// so a positive chain ID is all that is needed for replay protection from block 0.
+func ChainIDWithEIP155(chainID *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if chainID == nil || chainID.Sign() <= 0 {
+			logger.Error("Chain ID for EIP-155 must be positive", "chainID", chainID)
+			return
+		}
+		genesis.Config.ChainID = new(big.Int).Set(chainID)
+	}
+}
+
+// NetworkID sets the chain ID to the network ID. The genesis does not record the network ID,
+// which is given to each node by the --networkid flag (NETWORK_ID in the node configuration
+// written by homi setup), so the network ID is recorded in the genesis as the chain ID.
+// The nodes must still be started with the same network ID.
+func NetworkID(id uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if id == 0 {
+			logger.Error("Network ID must be positive", "networkID", id)
+			return
+		}
+		genesis.Config.ChainID = new(big.Int).SetUint64(id)
+	}
+}
+
 func UnitPrice(price uint64) Option {
 	return func(genesis *blockchain.Genesis) {
 		genesis.Config.UnitPrice = price
 	}
 }
 
+// ZeroGasPrice makes transactions free of charge. The unit price is set to zero and
+// the KIP-71 base fee is pinned to zero by zeroing its bounds, since the dynamic base fee
+// would otherwise be raised from zero after the Magma hardfork.
+// It must be applied after Governance since Governance replaces the whole governance config.
+func ZeroGasPrice() Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Config.UnitPrice = 0
+
+		governance := ensureGovernance(genesis)
+		if governance.KIP71 == nil {
+			governance.KIP71 = params.GetDefaultKIP71Config()
+		}
+		governance.KIP71.LowerBoundBaseFee = 0
+		governance.KIP71.UpperBoundBaseFee = 0
+
+		logger.Warn("Gas price is set to zero. Transactions are not throttled by fees and no transaction fee is rewarded")
+	}
+}
+
+// KIP71Defaults fills the KIP-71 config with the parameters of the Klaytn mainnet: the base fee bounded
+// between 25 and 750 ston, the gas target of 30M, the max block gas used for the base fee of 60M
+// and the base fee denominator of 20. The individual fields can be tweaked by the options applied later.
+// It must be applied after Governance since Governance replaces the whole governance config.
+func KIP71Defaults() Option {
+	return func(genesis *blockchain.Genesis) {
+		ensureGovernance(genesis).KIP71 = params.GetDefaultKIP71Config()
+	}
+}
+
+// RandaoCompatibleBlock activates the Randao hardfork at block n, from which the proposers are
+// selected with the randao mix. The Cancun hardfork must be activated at or before n.
+// The BLS public keys of the validators are read from the KIP-113 registry installed by AllocKip113Registry.
+func RandaoCompatibleBlock(n *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if n == nil || n.Sign() < 0 {
+			logger.Error("Randao block must be non-negative", "block", n)
+			return
+		}
+		prev := genesis.Config.RandaoCompatibleBlock
+		genesis.Config.RandaoCompatibleBlock = new(big.Int).Set(n)
+		if err := genesis.Config.CheckConfigForkOrder(); err != nil {
+			logger.Error("Invalid Randao block", "block", n, "err", err)
+			genesis.Config.RandaoCompatibleBlock = prev
+		}
+	}
+}
+
+// MagmaAt activates the Magma hardfork at block n with the fixed unit price used before it.
+// The London and EthTxType hardforks, which Magma requires, are activated at the same block
+// so that the chain runs with the pre-London rules before n. The Istanbul hardfork is activated
+// at n as well unless it is already activated earlier.
+// It must be applied after Governance since Governance replaces the whole governance config.
+func MagmaAt(n uint64, preMagmaUnitPrice uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		block := new(big.Int).SetUint64(n)
+		for _, later := range []*big.Int{
+			genesis.Config.KoreCompatibleBlock,
+			genesis.Config.ShanghaiCompatibleBlock,
+			genesis.Config.CancunCompatibleBlock,
+			genesis.Config.RandaoCompatibleBlock,
+		} {
+			if later != nil && later.Cmp(block) < 0 {
+				logger.Error("Magma must be activated before the later hardforks", "magma", n, "later", later)
+				return
+			}
+		}
+
+		if istanbul := genesis.Config.IstanbulCompatibleBlock; istanbul == nil || istanbul.Cmp(block) > 0 {
+			genesis.Config.IstanbulCompatibleBlock = new(big.Int).Set(block)
+		}
+		genesis.Config.LondonCompatibleBlock = new(big.Int).Set(block)
+		genesis.Config.EthTxTypeCompatibleBlock = new(big.Int).Set(block)
+		genesis.Config.MagmaCompatibleBlock = new(big.Int).Set(block)
+		genesis.Config.UnitPrice = preMagmaUnitPrice
+
+		governance := ensureGovernance(genesis)
+		if governance.KIP71 == nil {
+			governance.KIP71 = params.GetDefaultKIP71Config()
+		}
+	}
+}
+
 func Istanbul(config *params.IstanbulConfig) Option {
 	return func(genesis *blockchain.Genesis) {
 		genesis.Config.Istanbul = config
@@ -259,26 +721,236 @@ func DeriveShaImpl(impl int) Option {
 	}
 }
 
+// GenesisNumber sets the number of the genesis block for a synthetic genesis of a chain forked at
+// a nonzero height. The hardforks activated at or before n are in effect at the genesis block.
+// Genesis.Commit rejects a nonzero number, so such a genesis can be used by tools and tests
+// but cannot initialize a node.
+func GenesisNumber(n *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if n == nil || n.Sign() < 0 || !n.IsUint64() {
+			logger.Error("Genesis number must be a non-negative 64-bit integer", "number", n)
+			return
+		}
+		genesis.Number = n.Uint64()
+	}
+}
+
+// Coinbase sets the reward base of the genesis block, which is written to the "rewardbase" key
+// of the genesis file.
+func Coinbase(addr common.Address) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Coinbase = addr
+	}
+}
+
+// Difficulty sets the block score of the genesis block, which is the Klaytn counterpart of the difficulty.
+func Difficulty(d *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if d == nil || d.Sign() <= 0 {
+			logger.Error("Difficulty must be positive", "difficulty", d)
+			return
+		}
+		genesis.BlockScore = new(big.Int).Set(d)
+	}
+}
+
+// MixHash sets the mix hash of the genesis block, which is written to the "randaoMixHash" key
+// of the genesis file. The genesis header has the mix hash only if the Randao hardfork is enabled
+// at the genesis block, otherwise it is ignored.
+func MixHash(h common.Hash) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.MixHash = h
+	}
+}
+
+// Timestamp pins the genesis timestamp, which is the current time by default.
+// The genesis hash depends on both the timestamp and the derive-sha implementation
+// set by DeriveShaImpl, so both must be fixed to generate a reproducible genesis.
+func Timestamp(unix uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Timestamp = unix
+	}
+}
+
+// VerifyGenesisHash computes the hash of the genesis block and exits if it differs from the expected one,
+// which checks that a genesis is built reproducibly. It must be the last option since the hash depends on
+// everything set by the other options, including the timestamp and the derive-sha implementation.
+func VerifyGenesisHash(expected common.Hash) Option {
+	return func(genesis *blockchain.Genesis) {
+		if err := verifyGenesisHash(genesis, expected); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+}
+
+func verifyGenesisHash(genesis *blockchain.Genesis, expected common.Hash) error {
+	// The genesis block has no transactions, so its header is built with the empty root hash of
+	// its derive-sha implementation instead of calling InitDeriveSha, which would change the
+	// derive-sha implementation of the whole process.
+	emptyRoot := derivesha.EmptyRootHashOf(genesis.Config.DeriveShaImpl)
+	if hash := genesis.ToHeader(common.Hash{}, nil, emptyRoot).Hash(); hash != expected {
+		return fmt.Errorf("%w: expected %s, got %s", errGenesisHashMismatch, expected.Hex(), hash.Hex())
+	}
+	return nil
+}
+
 func Governance(config *params.GovernanceConfig) Option {
 	return func(genesis *blockchain.Genesis) {
 		genesis.Config.Governance = config
 	}
 }
 
+// GovernanceData sets the raw governance data of the genesis block, which is stored in the
+// Governance field of the genesis header. The data is not validated; it is usually the RLP-encoded
+// JSON of the governance parameters produced by blockchain.SetGenesisGovernance.
+func GovernanceData(raw []byte) Option {
+	return func(genesis *blockchain.Genesis) {
+		genesis.Governance = common.CopyBytes(raw)
+	}
+}
+
 func Clique(config *params.CliqueConfig) Option {
 	return func(genesis *blockchain.Genesis) {
 		genesis.Config.Clique = config
 	}
 }
 
+// MinimumStake sets the minimum amount of staking for a node to be a qualified validator.
+func MinimumStake(amount *big.Int) Option {
+	return func(genesis *blockchain.Genesis) {
+		if amount == nil || amount.Sign() < 0 {
+			logger.Error("Minimum stake must be non-negative", "amount", amount)
+			return
+		}
+		ensureGovernance(genesis).Reward.MinimumStake = new(big.Int).Set(amount)
+	}
+}
+
+// BlockPeriod sets the block generation period of Clique as CliquePeriod does.
+// Istanbul has no block period in the genesis since it is configured by the
+// block-generation-interval flag of each node, so the option is ignored for Istanbul.
+func BlockPeriod(seconds uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if genesis.Config.Istanbul != nil {
+			logger.Error("Istanbul block period cannot be set in genesis, use the block-generation-interval flag instead")
+			return
+		}
+		CliquePeriod(seconds)(genesis)
+	}
+}
+
+// CliquePeriod sets the block period of Clique in seconds, creating the clique config if needed.
+func CliquePeriod(period uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if period == 0 {
+			logger.Error("Clique period must be greater than zero", "period", period)
+			return
+		}
+		ensureClique(genesis).Period = period
+	}
+}
+
+// CliqueEpoch sets the number of blocks after which Clique resets the votes and checkpoints
+// the signers, creating the clique config if needed.
+func CliqueEpoch(epoch uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		ensureClique(genesis).Epoch = epoch
+	}
+}
+
+// StakingInterval sets the staking update interval. The interval is used as a denominator,
+// so zero is rejected. It should be a multiple of the proposer update interval so that the proposers
+// are refreshed whenever the staking information is updated, which is not checked here since the
+// result would depend on the order of StakingInterval and ProposerInterval.
 func StakingInterval(interval uint64) Option {
 	return func(genesis *blockchain.Genesis) {
-		genesis.Config.Governance.Reward.StakingUpdateInterval = interval
+		if interval == 0 {
+			logger.Error("Staking update interval must be greater than zero")
+			return
+		}
+		ensureGovernance(genesis).Reward.StakingUpdateInterval = interval
 	}
 }
 
+// ProposerInterval sets the proposer update interval. The interval is used as a denominator,
+// so zero is rejected. It should divide the staking update interval as StakingInterval describes.
 func ProposerInterval(interval uint64) Option {
 	return func(genesis *blockchain.Genesis) {
-		genesis.Config.Governance.Reward.ProposerUpdateInterval = interval
+		if interval == 0 {
+			logger.Error("Proposer update interval must be greater than zero")
+			return
+		}
+		ensureGovernance(genesis).Reward.ProposerUpdateInterval = interval
+	}
+}
+
+// ensureGovernance fills the governance config with the default values if it is not set yet.
+func ensureGovernance(genesis *blockchain.Genesis) *params.GovernanceConfig {
+	if genesis.Config.Governance == nil {
+		genesis.Config.Governance = params.GetDefaultGovernanceConfig()
+	}
+	if genesis.Config.Governance.Reward == nil {
+		genesis.Config.Governance.Reward = params.GetDefaultRewardConfig()
+	}
+	return genesis.Config.Governance
+}
+
+// ensureIstanbul fills the istanbul config with the default values if it is not set yet.
+func ensureIstanbul(genesis *blockchain.Genesis) *params.IstanbulConfig {
+	if genesis.Config.Istanbul == nil {
+		genesis.Config.Istanbul = params.GetDefaultIstanbulConfig()
+	}
+	return genesis.Config.Istanbul
+}
+
+// DeferredTxFee sets whether the transaction fees are distributed at the block finalization
+// together with the block reward instead of being transferred to the proposer right after each
+// transaction. It changes how the rewards are computed and the state root of every block, so all
+// nodes of the network must agree on it; it cannot be changed once the chain has started.
+func DeferredTxFee(enabled bool) Option {
+	return func(genesis *blockchain.Genesis) {
+		ensureGovernance(genesis).Reward.DeferredTxFee = enabled
+	}
+}
+
+// ensureClique fills the clique config with the default values if it is not set yet.
+func ensureClique(genesis *blockchain.Genesis) *params.CliqueConfig {
+	if genesis.Config.Clique == nil {
+		genesis.Config.Clique = params.GetDefaultCliqueConfig()
+	}
+	return genesis.Config.Clique
+}
+
+func UseGiniCoeff(use bool) Option {
+	return func(genesis *blockchain.Genesis) {
+		ensureGovernance(genesis).Reward.UseGiniCoeff = use
+	}
+}
+
+func Epoch(epoch uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if epoch == 0 {
+			logger.Error("Epoch must be greater than zero", "epoch", epoch)
+			return
+		}
+		ensureIstanbul(genesis).Epoch = epoch
+	}
+}
+
+// Proposers sets how the proposers are selected: the proposer policy and the number of
+// validators in the committee of each block.
+func Proposers(policy istanbul.ProposerPolicy, subGroupSize uint64) Option {
+	return func(genesis *blockchain.Genesis) {
+		if policy > istanbul.WeightedRandom {
+			logger.Error("Unknown proposer policy", "policy", policy)
+			return
+		}
+		if subGroupSize == 0 {
+			logger.Error("Sub group size must be greater than zero", "subGroupSize", subGroupSize)
+			return
+		}
+		config := ensureIstanbul(genesis)
+		config.ProposerPolicy = uint64(policy)
+		config.SubGroupSize = subGroupSize
 	}
 }
@@ -0,0 +1,606 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package allowlist
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = klaytn.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AllowlistMetaData contains all meta data concerning the Allowlist contract.
+var AllowlistMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_admin\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"_allowed\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Allowed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Disallowed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"admin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"allow\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"disallow\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"f851a440": "admin()",
+		"ff9913e8": "allow(address)",
+		"a9ed9cb8": "disallow(address)",
+		"babcc539": "isAllowed(address)",
+	},
+	Bin: "0x608060405234801561001057600080fd5b5060405161052b38038061052b83398101604081905261002f9161019b565b6001600160a01b0382166100775760405162461bcd60e51b815260206004820152600a6024820152692d32b9379030b236b4b760b11b60448201526064015b60405180910390fd5b600080546001600160a01b0319166001600160a01b0384161781555b81518110156101615760006001600160a01b03168282815181106100b9576100b9610274565b60200260200101516001600160a01b0316036101065760405162461bcd60e51b815260206004820152600c60248201526b16995c9bc81858d8dbdd5b9d60a21b604482015260640161006e565b600180600084848151811061011d5761011d610274565b6020908102919091018101516001600160a01b03168252810191909152604001600020805460ff1916911515919091179055806101598161028a565b915050610093565b5050506102b1565b80516001600160a01b038116811461018057600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b600080604083850312156101ae57600080fd5b6101b783610169565b602084810151919350906001600160401b03808211156101d657600080fd5b818601915086601f8301126101ea57600080fd5b8151818111156101fc576101fc610185565b8060051b604051601f19603f8301168101818110858211171561022157610221610185565b60405291825284820192508381018501918983111561023f57600080fd5b938501935b828510156102645761025585610169565b84529385019392850192610244565b8096505050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b6000600182016102aa57634e487b7160e01b600052601160045260246000fd5b5060010190565b61026b806102c06000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c8063a9ed9cb814610051578063babcc53914610066578063f851a4401461009e578063ff9913e8146100c9575b600080fd5b61006461005f366004610205565b6100dc565b005b610089610074366004610205565b60016020526000908152604090205460ff1681565b60405190151581526020015b60405180910390f35b6000546100b1906001600160a01b031681565b6040516001600160a01b039091168152602001610095565b6100646100d7366004610205565b610170565b6000546001600160a01b031633146101275760405162461bcd60e51b81526020600482015260096024820152682737ba1030b236b4b760b91b60448201526064015b60405180910390fd5b6001600160a01b038116600081815260016020526040808220805460ff19169055517f9ef90a89b00db1a1891a357dc96b2a273add9d883e378c350d22bad87a9d7d309190a250565b6000546001600160a01b031633146101b65760405162461bcd60e51b81526020600482015260096024820152682737ba1030b236b4b760b91b604482015260640161011e565b6001600160a01b0381166000818152600160208190526040808320805460ff1916909217909155517f77a7dbc6ad97703ad411a8d5edfcd1df382fb34b076a90898b11884f7ebdcc059190a250565b60006020828403121561021757600080fd5b81356001600160a01b038116811461022e57600080fd5b939250505056fea2646970667358221220d11e92672e8e5948ea02c4adc39320e693947c7e84c125943918d88e69e07b0264736f6c63430008150033",
+}
+
+// AllowlistABI is the input ABI used to generate the binding from.
+// Deprecated: Use AllowlistMetaData.ABI instead.
+var AllowlistABI = AllowlistMetaData.ABI
+
+// AllowlistBinRuntime is the compiled bytecode used for adding genesis block without deploying code.
+const AllowlistBinRuntime = `608060405234801561001057600080fd5b506004361061004c5760003560e01c8063a9ed9cb814610051578063babcc53914610066578063f851a4401461009e578063ff9913e8146100c9575b600080fd5b61006461005f366004610205565b6100dc565b005b610089610074366004610205565b60016020526000908152604090205460ff1681565b60405190151581526020015b60405180910390f35b6000546100b1906001600160a01b031681565b6040516001600160a01b039091168152602001610095565b6100646100d7366004610205565b610170565b6000546001600160a01b031633146101275760405162461bcd60e51b81526020600482015260096024820152682737ba1030b236b4b760b91b60448201526064015b60405180910390fd5b6001600160a01b038116600081815260016020526040808220805460ff19169055517f9ef90a89b00db1a1891a357dc96b2a273add9d883e378c350d22bad87a9d7d309190a250565b6000546001600160a01b031633146101b65760405162461bcd60e51b81526020600482015260096024820152682737ba1030b236b4b760b91b604482015260640161011e565b6001600160a01b0381166000818152600160208190526040808320805460ff1916909217909155517f77a7dbc6ad97703ad411a8d5edfcd1df382fb34b076a90898b11884f7ebdcc059190a250565b60006020828403121561021757600080fd5b81356001600160a01b038116811461022e57600080fd5b939250505056fea2646970667358221220d11e92672e8e5948ea02c4adc39320e693947c7e84c125943918d88e69e07b0264736f6c63430008150033`
+
+// AllowlistFuncSigs maps the 4-byte function signature to its string representation.
+// Deprecated: Use AllowlistMetaData.Sigs instead.
+var AllowlistFuncSigs = AllowlistMetaData.Sigs
+
+// AllowlistBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use AllowlistMetaData.Bin instead.
+var AllowlistBin = AllowlistMetaData.Bin
+
+// DeployAllowlist deploys a new Klaytn contract, binding an instance of Allowlist to it.
+func DeployAllowlist(auth *bind.TransactOpts, backend bind.ContractBackend, _admin common.Address, _allowed []common.Address) (common.Address, *types.Transaction, *Allowlist, error) {
+	parsed, err := AllowlistMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AllowlistBin), backend, _admin, _allowed)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Allowlist{AllowlistCaller: AllowlistCaller{contract: contract}, AllowlistTransactor: AllowlistTransactor{contract: contract}, AllowlistFilterer: AllowlistFilterer{contract: contract}}, nil
+}
+
+// Allowlist is an auto generated Go binding around a Klaytn contract.
+type Allowlist struct {
+	AllowlistCaller     // Read-only binding to the contract
+	AllowlistTransactor // Write-only binding to the contract
+	AllowlistFilterer   // Log filterer for contract events
+}
+
+// AllowlistCaller is an auto generated read-only Go binding around a Klaytn contract.
+type AllowlistCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AllowlistTransactor is an auto generated write-only Go binding around a Klaytn contract.
+type AllowlistTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AllowlistFilterer is an auto generated log filtering Go binding around a Klaytn contract events.
+type AllowlistFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AllowlistSession is an auto generated Go binding around a Klaytn contract,
+// with pre-set call and transact options.
+type AllowlistSession struct {
+	Contract     *Allowlist        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AllowlistCallerSession is an auto generated read-only Go binding around a Klaytn contract,
+// with pre-set call options.
+type AllowlistCallerSession struct {
+	Contract *AllowlistCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// AllowlistTransactorSession is an auto generated write-only Go binding around a Klaytn contract,
+// with pre-set transact options.
+type AllowlistTransactorSession struct {
+	Contract     *AllowlistTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// AllowlistRaw is an auto generated low-level Go binding around a Klaytn contract.
+type AllowlistRaw struct {
+	Contract *Allowlist // Generic contract binding to access the raw methods on
+}
+
+// AllowlistCallerRaw is an auto generated low-level read-only Go binding around a Klaytn contract.
+type AllowlistCallerRaw struct {
+	Contract *AllowlistCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AllowlistTransactorRaw is an auto generated low-level write-only Go binding around a Klaytn contract.
+type AllowlistTransactorRaw struct {
+	Contract *AllowlistTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAllowlist creates a new instance of Allowlist, bound to a specific deployed contract.
+func NewAllowlist(address common.Address, backend bind.ContractBackend) (*Allowlist, error) {
+	contract, err := bindAllowlist(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Allowlist{AllowlistCaller: AllowlistCaller{contract: contract}, AllowlistTransactor: AllowlistTransactor{contract: contract}, AllowlistFilterer: AllowlistFilterer{contract: contract}}, nil
+}
+
+// NewAllowlistCaller creates a new read-only instance of Allowlist, bound to a specific deployed contract.
+func NewAllowlistCaller(address common.Address, caller bind.ContractCaller) (*AllowlistCaller, error) {
+	contract, err := bindAllowlist(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowlistCaller{contract: contract}, nil
+}
+
+// NewAllowlistTransactor creates a new write-only instance of Allowlist, bound to a specific deployed contract.
+func NewAllowlistTransactor(address common.Address, transactor bind.ContractTransactor) (*AllowlistTransactor, error) {
+	contract, err := bindAllowlist(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowlistTransactor{contract: contract}, nil
+}
+
+// NewAllowlistFilterer creates a new log filterer instance of Allowlist, bound to a specific deployed contract.
+func NewAllowlistFilterer(address common.Address, filterer bind.ContractFilterer) (*AllowlistFilterer, error) {
+	contract, err := bindAllowlist(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowlistFilterer{contract: contract}, nil
+}
+
+// bindAllowlist binds a generic wrapper to an already deployed contract.
+func bindAllowlist(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AllowlistMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Allowlist *AllowlistRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Allowlist.Contract.AllowlistCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Allowlist *AllowlistRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Allowlist.Contract.AllowlistTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Allowlist *AllowlistRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Allowlist.Contract.AllowlistTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Allowlist *AllowlistCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Allowlist.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Allowlist *AllowlistTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Allowlist.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Allowlist *AllowlistTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Allowlist.Contract.contract.Transact(opts, method, params...)
+}
+
+// Admin is a free data retrieval call binding the contract method 0xf851a440.
+//
+// Solidity: function admin() view returns(address)
+func (_Allowlist *AllowlistCaller) Admin(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Allowlist.contract.Call(opts, &out, "admin")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Admin is a free data retrieval call binding the contract method 0xf851a440.
+//
+// Solidity: function admin() view returns(address)
+func (_Allowlist *AllowlistSession) Admin() (common.Address, error) {
+	return _Allowlist.Contract.Admin(&_Allowlist.CallOpts)
+}
+
+// Admin is a free data retrieval call binding the contract method 0xf851a440.
+//
+// Solidity: function admin() view returns(address)
+func (_Allowlist *AllowlistCallerSession) Admin() (common.Address, error) {
+	return _Allowlist.Contract.Admin(&_Allowlist.CallOpts)
+}
+
+// IsAllowed is a free data retrieval call binding the contract method 0xbabcc539.
+//
+// Solidity: function isAllowed(address ) view returns(bool)
+func (_Allowlist *AllowlistCaller) IsAllowed(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _Allowlist.contract.Call(opts, &out, "isAllowed", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsAllowed is a free data retrieval call binding the contract method 0xbabcc539.
+//
+// Solidity: function isAllowed(address ) view returns(bool)
+func (_Allowlist *AllowlistSession) IsAllowed(arg0 common.Address) (bool, error) {
+	return _Allowlist.Contract.IsAllowed(&_Allowlist.CallOpts, arg0)
+}
+
+// IsAllowed is a free data retrieval call binding the contract method 0xbabcc539.
+//
+// Solidity: function isAllowed(address ) view returns(bool)
+func (_Allowlist *AllowlistCallerSession) IsAllowed(arg0 common.Address) (bool, error) {
+	return _Allowlist.Contract.IsAllowed(&_Allowlist.CallOpts, arg0)
+}
+
+// Allow is a paid mutator transaction binding the contract method 0xff9913e8.
+//
+// Solidity: function allow(address account) returns()
+func (_Allowlist *AllowlistTransactor) Allow(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _Allowlist.contract.Transact(opts, "allow", account)
+}
+
+// Allow is a paid mutator transaction binding the contract method 0xff9913e8.
+//
+// Solidity: function allow(address account) returns()
+func (_Allowlist *AllowlistSession) Allow(account common.Address) (*types.Transaction, error) {
+	return _Allowlist.Contract.Allow(&_Allowlist.TransactOpts, account)
+}
+
+// Allow is a paid mutator transaction binding the contract method 0xff9913e8.
+//
+// Solidity: function allow(address account) returns()
+func (_Allowlist *AllowlistTransactorSession) Allow(account common.Address) (*types.Transaction, error) {
+	return _Allowlist.Contract.Allow(&_Allowlist.TransactOpts, account)
+}
+
+// Disallow is a paid mutator transaction binding the contract method 0xa9ed9cb8.
+//
+// Solidity: function disallow(address account) returns()
+func (_Allowlist *AllowlistTransactor) Disallow(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _Allowlist.contract.Transact(opts, "disallow", account)
+}
+
+// Disallow is a paid mutator transaction binding the contract method 0xa9ed9cb8.
+//
+// Solidity: function disallow(address account) returns()
+func (_Allowlist *AllowlistSession) Disallow(account common.Address) (*types.Transaction, error) {
+	return _Allowlist.Contract.Disallow(&_Allowlist.TransactOpts, account)
+}
+
+// Disallow is a paid mutator transaction binding the contract method 0xa9ed9cb8.
+//
+// Solidity: function disallow(address account) returns()
+func (_Allowlist *AllowlistTransactorSession) Disallow(account common.Address) (*types.Transaction, error) {
+	return _Allowlist.Contract.Disallow(&_Allowlist.TransactOpts, account)
+}
+
+// AllowlistAllowedIterator is returned from FilterAllowed and is used to iterate over the raw logs and unpacked data for Allowed events raised by the Allowlist contract.
+type AllowlistAllowedIterator struct {
+	Event *AllowlistAllowed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log      // Log channel receiving the found contract events
+	sub  klaytn.Subscription // Subscription for errors, completion and termination
+	done bool                // Whether the subscription completed delivering logs
+	fail error               // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AllowlistAllowedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AllowlistAllowed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AllowlistAllowed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AllowlistAllowedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AllowlistAllowedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AllowlistAllowed represents a Allowed event raised by the Allowlist contract.
+type AllowlistAllowed struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterAllowed is a free log retrieval operation binding the contract event 0x77a7dbc6ad97703ad411a8d5edfcd1df382fb34b076a90898b11884f7ebdcc05.
+//
+// Solidity: event Allowed(address indexed account)
+func (_Allowlist *AllowlistFilterer) FilterAllowed(opts *bind.FilterOpts, account []common.Address) (*AllowlistAllowedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _Allowlist.contract.FilterLogs(opts, "Allowed", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowlistAllowedIterator{contract: _Allowlist.contract, event: "Allowed", logs: logs, sub: sub}, nil
+}
+
+// WatchAllowed is a free log subscription operation binding the contract event 0x77a7dbc6ad97703ad411a8d5edfcd1df382fb34b076a90898b11884f7ebdcc05.
+//
+// Solidity: event Allowed(address indexed account)
+func (_Allowlist *AllowlistFilterer) WatchAllowed(opts *bind.WatchOpts, sink chan<- *AllowlistAllowed, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _Allowlist.contract.WatchLogs(opts, "Allowed", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AllowlistAllowed)
+				if err := _Allowlist.contract.UnpackLog(event, "Allowed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAllowed is a log parse operation binding the contract event 0x77a7dbc6ad97703ad411a8d5edfcd1df382fb34b076a90898b11884f7ebdcc05.
+//
+// Solidity: event Allowed(address indexed account)
+func (_Allowlist *AllowlistFilterer) ParseAllowed(log types.Log) (*AllowlistAllowed, error) {
+	event := new(AllowlistAllowed)
+	if err := _Allowlist.contract.UnpackLog(event, "Allowed", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// AllowlistDisallowedIterator is returned from FilterDisallowed and is used to iterate over the raw logs and unpacked data for Disallowed events raised by the Allowlist contract.
+type AllowlistDisallowedIterator struct {
+	Event *AllowlistDisallowed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log      // Log channel receiving the found contract events
+	sub  klaytn.Subscription // Subscription for errors, completion and termination
+	done bool                // Whether the subscription completed delivering logs
+	fail error               // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AllowlistDisallowedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AllowlistDisallowed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AllowlistDisallowed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AllowlistDisallowedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AllowlistDisallowedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AllowlistDisallowed represents a Disallowed event raised by the Allowlist contract.
+type AllowlistDisallowed struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterDisallowed is a free log retrieval operation binding the contract event 0x9ef90a89b00db1a1891a357dc96b2a273add9d883e378c350d22bad87a9d7d30.
+//
+// Solidity: event Disallowed(address indexed account)
+func (_Allowlist *AllowlistFilterer) FilterDisallowed(opts *bind.FilterOpts, account []common.Address) (*AllowlistDisallowedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _Allowlist.contract.FilterLogs(opts, "Disallowed", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowlistDisallowedIterator{contract: _Allowlist.contract, event: "Disallowed", logs: logs, sub: sub}, nil
+}
+
+// WatchDisallowed is a free log subscription operation binding the contract event 0x9ef90a89b00db1a1891a357dc96b2a273add9d883e378c350d22bad87a9d7d30.
+//
+// Solidity: event Disallowed(address indexed account)
+func (_Allowlist *AllowlistFilterer) WatchDisallowed(opts *bind.WatchOpts, sink chan<- *AllowlistDisallowed, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _Allowlist.contract.WatchLogs(opts, "Disallowed", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AllowlistDisallowed)
+				if err := _Allowlist.contract.UnpackLog(event, "Disallowed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDisallowed is a log parse operation binding the contract event 0x9ef90a89b00db1a1891a357dc96b2a273add9d883e378c350d22bad87a9d7d30.
+//
+// Solidity: event Disallowed(address indexed account)
+func (_Allowlist *AllowlistFilterer) ParseDisallowed(log types.Log) (*AllowlistDisallowed, error) {
+	event := new(AllowlistDisallowed)
+	if err := _Allowlist.contract.UnpackLog(event, "Disallowed", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
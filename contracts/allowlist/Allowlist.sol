@@ -0,0 +1,65 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Allowlist keeps the accounts allowed by its admin.
+ * It only keeps the list; enforcing it is up to the contracts and the tools using it.
+ * Note: homi installs it at the genesis block with its admin and allowed accounts in the storage,
+ * in which case the constructor is not executed.
+ */
+contract Allowlist {
+    address public admin;
+    mapping(address => bool) public isAllowed;
+
+    event Allowed(address indexed account);
+    event Disallowed(address indexed account);
+
+    /**
+     * @dev Throws if called by any account other than the admin.
+     */
+    modifier onlyAdmin() {
+        require(msg.sender == admin, "Not admin");
+        _;
+    }
+
+    constructor(address _admin, address[] memory _allowed) {
+        require(_admin != address(0), "Zero admin");
+        admin = _admin;
+        for (uint256 i = 0; i < _allowed.length; i++) {
+            require(_allowed[i] != address(0), "Zero account");
+            isAllowed[_allowed[i]] = true;
+        }
+    }
+
+    /**
+     * @dev Allows the account.
+     */
+    function allow(address account) external onlyAdmin {
+        isAllowed[account] = true;
+        emit Allowed(account);
+    }
+
+    /**
+     * @dev Disallows the account.
+     */
+    function disallow(address account) external onlyAdmin {
+        isAllowed[account] = false;
+        emit Disallowed(account);
+    }
+}
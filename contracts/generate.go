@@ -32,6 +32,10 @@ package contracts
 
 //go:generate abigen --sol ./system_contracts/all.sol --pkg system_contracts --out ./system_contracts/all.go
 
+//go:generate abigen --sol ./allowlist/Allowlist.sol --pkg allowlist --out ./allowlist/Allowlist.go
+//go:generate abigen --sol ./multisig/MultiSig.sol --pkg multisig --out ./multisig/MultiSig.go
+//go:generate abigen --sol ./vesting/Vesting.sol --pkg vesting --out ./vesting/Vesting.go
+
 //`credit.sol` was compiled by solidity@0.4.24.
 // This code data was included in cypress genesis file.
 ////go:generate abigen --sol ./cypress/credit.sol --pkg cypress --out ./cypress/credit.go
@@ -0,0 +1,764 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package multisig
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = klaytn.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MultiSigMetaData contains all meta data concerning the MultiSig contract.
+var MultiSigMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_owners\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"Confirmation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"Execution\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"callHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"confirm\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"confirmations\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"confirmed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"execute\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"threshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Sigs: map[string]string{
+		"f92f6e24": "callHash(address,uint256,bytes)",
+		"797af627": "confirm(bytes32)",
+		"ec95bfe7": "confirmations(bytes32)",
+		"742aad2e": "confirmed(bytes32,address)",
+		"b61d27f6": "execute(address,uint256,bytes)",
+		"2f54bf6e": "isOwner(address)",
+		"affed0e0": "nonce()",
+		"42cde4e8": "threshold()",
+	},
+	Bin: "0x608060405234801561001057600080fd5b5060405161092e38038061092e83398101604081905261002f916101db565b600081118015610040575081518111155b6100855760405162461bcd60e51b8152602060048201526011602482015270125b9d985b1a59081d1a1c995cda1bdb19607a1b60448201526064015b60405180910390fd5b60005b825181101561019f5760006001600160a01b03168382815181106100ae576100ae6102a7565b60200260200101516001600160a01b0316141580156101075750600260008483815181106100de576100de6102a7565b6020908102919091018101516001600160a01b031682528101919091526040016000205460ff16155b6101435760405162461bcd60e51b815260206004820152600d60248201526c24b73b30b634b21037bbb732b960991b604482015260640161007c565b60016002600085848151811061015b5761015b6102a7565b6020908102919091018101516001600160a01b03168252810191909152604001600020805460ff191691151591909117905580610197816102bd565b915050610088565b50600055506102e4565b634e487b7160e01b600052604160045260246000fd5b80516001600160a01b03811681146101d657600080fd5b919050565b600080604083850312156101ee57600080fd5b82516001600160401b038082111561020557600080fd5b818501915085601f83011261021957600080fd5b815160208282111561022d5761022d6101a9565b8160051b604051601f19603f83011681018181108682111715610252576102526101a9565b60405292835281830193508481018201928984111561027057600080fd5b948201945b8386101561029557610286866101bf565b85529482019493820193610275565b97909101519698969750505050505050565b634e487b7160e01b600052603260045260246000fd5b6000600182016102dd57634e487b7160e01b600052601160045260246000fd5b5060010190565b61063b806102f36000396000f3fe60806040526004361061007f5760003560e01c8063affed0e01161004e578063affed0e014610151578063b61d27f614610167578063ec95bfe714610187578063f92f6e24146101b457600080fd5b80632f54bf6e1461008b57806342cde4e8146100d0578063742aad2e146100f4578063797af6271461012f57600080fd5b3661008657005b600080fd5b34801561009757600080fd5b506100bb6100a6366004610491565b60026020526000908152604090205460ff1681565b60405190151581526020015b60405180910390f35b3480156100dc57600080fd5b506100e660005481565b6040519081526020016100c7565b34801561010057600080fd5b506100bb61010f3660046104b3565b600460209081526000928352604080842090915290825290205460ff1681565b34801561013b57600080fd5b5061014f61014a3660046104df565b6101d4565b005b34801561015d57600080fd5b506100e660015481565b34801561017357600080fd5b5061014f6101823660046104f8565b6102f1565b34801561019357600080fd5b506100e66101a23660046104df565b60036020526000908152604090205481565b3480156101c057600080fd5b506100e66101cf3660046104f8565b610438565b3360009081526002602052604090205460ff166102245760405162461bcd60e51b81526020600482015260096024820152682737ba1037bbb732b960b91b60448201526064015b60405180910390fd5b600081815260046020908152604080832033845290915290205460ff16156102825760405162461bcd60e51b8152602060048201526011602482015270105b1c9958591e4818dbdb999a5c9b5959607a1b604482015260640161021b565b60008181526004602090815260408083203384528252808320805460ff19166001179055838352600390915281208054916102bc8361057f565b9091555050604051819033907fe1c52dc63b719ade82e8bea94cc41a0d5d28e4aaf536adb5e9cccc9ff8c1aeda90600090a350565b60006102ff85858585610438565b60008054828252600360205260409091205491925011156103525760405162461bcd60e51b815260206004820152600d60248201526c139bdd0818dbdb999a5c9b5959609a1b604482015260640161021b565b600180549060006103628361057f565b91905055506000856001600160a01b03168585856040516103849291906105a6565b60006040518083038185875af1925050503d80600081146103c1576040519150601f19603f3d011682016040523d82523d6000602084013e6103c6565b606091505b50509050806104055760405162461bcd60e51b815260206004820152600b60248201526a10d85b1b0819985a5b195960aa1b604482015260640161021b565b60405182907f7e9e1cb65db4927b1815f498cbaa226a15c277816f7df407573682110522c9b190600090a2505050505050565b6000600154858585856040516020016104559594939291906105b6565b604051602081830303815290604052805190602001209050949350505050565b80356001600160a01b038116811461048c57600080fd5b919050565b6000602082840312156104a357600080fd5b6104ac82610475565b9392505050565b600080604083850312156104c657600080fd5b823591506104d660208401610475565b90509250929050565b6000602082840312156104f157600080fd5b5035919050565b6000806000806060858703121561050e57600080fd5b61051785610475565b935060208501359250604085013567ffffffffffffffff8082111561053b57600080fd5b818701915087601f83011261054f57600080fd5b81358181111561055e57600080fd5b88602082850101111561057057600080fd5b95989497505060200194505050565b60006001820161059f57634e487b7160e01b600052601160045260246000fd5b5060010190565b8183823760009101908152919050565b8581526001600160a01b0385166020820152604081018490526080606082018190528101829052818360a0830137600081830160a090810191909152601f909201601f1916010194935050505056fea26469706673582212203056aee0e515e654e4629866d7d4d098e1a6a221604f08e2e37b2fa382170a2964736f6c63430008150033",
+}
+
+// MultiSigABI is the input ABI used to generate the binding from.
+// Deprecated: Use MultiSigMetaData.ABI instead.
+var MultiSigABI = MultiSigMetaData.ABI
+
+// MultiSigBinRuntime is the compiled bytecode used for adding genesis block without deploying code.
+const MultiSigBinRuntime = `60806040526004361061007f5760003560e01c8063affed0e01161004e578063affed0e014610151578063b61d27f614610167578063ec95bfe714610187578063f92f6e24146101b457600080fd5b80632f54bf6e1461008b57806342cde4e8146100d0578063742aad2e146100f4578063797af6271461012f57600080fd5b3661008657005b600080fd5b34801561009757600080fd5b506100bb6100a6366004610491565b60026020526000908152604090205460ff1681565b60405190151581526020015b60405180910390f35b3480156100dc57600080fd5b506100e660005481565b6040519081526020016100c7565b34801561010057600080fd5b506100bb61010f3660046104b3565b600460209081526000928352604080842090915290825290205460ff1681565b34801561013b57600080fd5b5061014f61014a3660046104df565b6101d4565b005b34801561015d57600080fd5b506100e660015481565b34801561017357600080fd5b5061014f6101823660046104f8565b6102f1565b34801561019357600080fd5b506100e66101a23660046104df565b60036020526000908152604090205481565b3480156101c057600080fd5b506100e66101cf3660046104f8565b610438565b3360009081526002602052604090205460ff166102245760405162461bcd60e51b81526020600482015260096024820152682737ba1037bbb732b960b91b60448201526064015b60405180910390fd5b600081815260046020908152604080832033845290915290205460ff16156102825760405162461bcd60e51b8152602060048201526011602482015270105b1c9958591e4818dbdb999a5c9b5959607a1b604482015260640161021b565b60008181526004602090815260408083203384528252808320805460ff19166001179055838352600390915281208054916102bc8361057f565b9091555050604051819033907fe1c52dc63b719ade82e8bea94cc41a0d5d28e4aaf536adb5e9cccc9ff8c1aeda90600090a350565b60006102ff85858585610438565b60008054828252600360205260409091205491925011156103525760405162461bcd60e51b815260206004820152600d60248201526c139bdd0818dbdb999a5c9b5959609a1b604482015260640161021b565b600180549060006103628361057f565b91905055506000856001600160a01b03168585856040516103849291906105a6565b60006040518083038185875af1925050503d80600081146103c1576040519150601f19603f3d011682016040523d82523d6000602084013e6103c6565b606091505b50509050806104055760405162461bcd60e51b815260206004820152600b60248201526a10d85b1b0819985a5b195960aa1b604482015260640161021b565b60405182907f7e9e1cb65db4927b1815f498cbaa226a15c277816f7df407573682110522c9b190600090a2505050505050565b6000600154858585856040516020016104559594939291906105b6565b604051602081830303815290604052805190602001209050949350505050565b80356001600160a01b038116811461048c57600080fd5b919050565b6000602082840312156104a357600080fd5b6104ac82610475565b9392505050565b600080604083850312156104c657600080fd5b823591506104d660208401610475565b90509250929050565b6000602082840312156104f157600080fd5b5035919050565b6000806000806060858703121561050e57600080fd5b61051785610475565b935060208501359250604085013567ffffffffffffffff8082111561053b57600080fd5b818701915087601f83011261054f57600080fd5b81358181111561055e57600080fd5b88602082850101111561057057600080fd5b95989497505060200194505050565b60006001820161059f57634e487b7160e01b600052601160045260246000fd5b5060010190565b8183823760009101908152919050565b8581526001600160a01b0385166020820152604081018490526080606082018190528101829052818360a0830137600081830160a090810191909152601f909201601f1916010194935050505056fea26469706673582212203056aee0e515e654e4629866d7d4d098e1a6a221604f08e2e37b2fa382170a2964736f6c63430008150033`
+
+// MultiSigFuncSigs maps the 4-byte function signature to its string representation.
+// Deprecated: Use MultiSigMetaData.Sigs instead.
+var MultiSigFuncSigs = MultiSigMetaData.Sigs
+
+// MultiSigBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MultiSigMetaData.Bin instead.
+var MultiSigBin = MultiSigMetaData.Bin
+
+// DeployMultiSig deploys a new Klaytn contract, binding an instance of MultiSig to it.
+func DeployMultiSig(auth *bind.TransactOpts, backend bind.ContractBackend, _owners []common.Address, _threshold *big.Int) (common.Address, *types.Transaction, *MultiSig, error) {
+	parsed, err := MultiSigMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MultiSigBin), backend, _owners, _threshold)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MultiSig{MultiSigCaller: MultiSigCaller{contract: contract}, MultiSigTransactor: MultiSigTransactor{contract: contract}, MultiSigFilterer: MultiSigFilterer{contract: contract}}, nil
+}
+
+// MultiSig is an auto generated Go binding around a Klaytn contract.
+type MultiSig struct {
+	MultiSigCaller     // Read-only binding to the contract
+	MultiSigTransactor // Write-only binding to the contract
+	MultiSigFilterer   // Log filterer for contract events
+}
+
+// MultiSigCaller is an auto generated read-only Go binding around a Klaytn contract.
+type MultiSigCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MultiSigTransactor is an auto generated write-only Go binding around a Klaytn contract.
+type MultiSigTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MultiSigFilterer is an auto generated log filtering Go binding around a Klaytn contract events.
+type MultiSigFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MultiSigSession is an auto generated Go binding around a Klaytn contract,
+// with pre-set call and transact options.
+type MultiSigSession struct {
+	Contract     *MultiSig         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MultiSigCallerSession is an auto generated read-only Go binding around a Klaytn contract,
+// with pre-set call options.
+type MultiSigCallerSession struct {
+	Contract *MultiSigCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// MultiSigTransactorSession is an auto generated write-only Go binding around a Klaytn contract,
+// with pre-set transact options.
+type MultiSigTransactorSession struct {
+	Contract     *MultiSigTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// MultiSigRaw is an auto generated low-level Go binding around a Klaytn contract.
+type MultiSigRaw struct {
+	Contract *MultiSig // Generic contract binding to access the raw methods on
+}
+
+// MultiSigCallerRaw is an auto generated low-level read-only Go binding around a Klaytn contract.
+type MultiSigCallerRaw struct {
+	Contract *MultiSigCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MultiSigTransactorRaw is an auto generated low-level write-only Go binding around a Klaytn contract.
+type MultiSigTransactorRaw struct {
+	Contract *MultiSigTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMultiSig creates a new instance of MultiSig, bound to a specific deployed contract.
+func NewMultiSig(address common.Address, backend bind.ContractBackend) (*MultiSig, error) {
+	contract, err := bindMultiSig(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSig{MultiSigCaller: MultiSigCaller{contract: contract}, MultiSigTransactor: MultiSigTransactor{contract: contract}, MultiSigFilterer: MultiSigFilterer{contract: contract}}, nil
+}
+
+// NewMultiSigCaller creates a new read-only instance of MultiSig, bound to a specific deployed contract.
+func NewMultiSigCaller(address common.Address, caller bind.ContractCaller) (*MultiSigCaller, error) {
+	contract, err := bindMultiSig(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigCaller{contract: contract}, nil
+}
+
+// NewMultiSigTransactor creates a new write-only instance of MultiSig, bound to a specific deployed contract.
+func NewMultiSigTransactor(address common.Address, transactor bind.ContractTransactor) (*MultiSigTransactor, error) {
+	contract, err := bindMultiSig(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigTransactor{contract: contract}, nil
+}
+
+// NewMultiSigFilterer creates a new log filterer instance of MultiSig, bound to a specific deployed contract.
+func NewMultiSigFilterer(address common.Address, filterer bind.ContractFilterer) (*MultiSigFilterer, error) {
+	contract, err := bindMultiSig(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigFilterer{contract: contract}, nil
+}
+
+// bindMultiSig binds a generic wrapper to an already deployed contract.
+func bindMultiSig(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MultiSigMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MultiSig *MultiSigRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MultiSig.Contract.MultiSigCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MultiSig *MultiSigRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MultiSig.Contract.MultiSigTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MultiSig *MultiSigRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MultiSig.Contract.MultiSigTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MultiSig *MultiSigCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MultiSig.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MultiSig *MultiSigTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MultiSig.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MultiSig *MultiSigTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MultiSig.Contract.contract.Transact(opts, method, params...)
+}
+
+// CallHash is a free data retrieval call binding the contract method 0xf92f6e24.
+//
+// Solidity: function callHash(address to, uint256 value, bytes data) view returns(bytes32)
+func (_MultiSig *MultiSigCaller) CallHash(opts *bind.CallOpts, to common.Address, value *big.Int, data []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _MultiSig.contract.Call(opts, &out, "callHash", to, value, data)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// CallHash is a free data retrieval call binding the contract method 0xf92f6e24.
+//
+// Solidity: function callHash(address to, uint256 value, bytes data) view returns(bytes32)
+func (_MultiSig *MultiSigSession) CallHash(to common.Address, value *big.Int, data []byte) ([32]byte, error) {
+	return _MultiSig.Contract.CallHash(&_MultiSig.CallOpts, to, value, data)
+}
+
+// CallHash is a free data retrieval call binding the contract method 0xf92f6e24.
+//
+// Solidity: function callHash(address to, uint256 value, bytes data) view returns(bytes32)
+func (_MultiSig *MultiSigCallerSession) CallHash(to common.Address, value *big.Int, data []byte) ([32]byte, error) {
+	return _MultiSig.Contract.CallHash(&_MultiSig.CallOpts, to, value, data)
+}
+
+// Confirmations is a free data retrieval call binding the contract method 0xec95bfe7.
+//
+// Solidity: function confirmations(bytes32 ) view returns(uint256)
+func (_MultiSig *MultiSigCaller) Confirmations(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MultiSig.contract.Call(opts, &out, "confirmations", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Confirmations is a free data retrieval call binding the contract method 0xec95bfe7.
+//
+// Solidity: function confirmations(bytes32 ) view returns(uint256)
+func (_MultiSig *MultiSigSession) Confirmations(arg0 [32]byte) (*big.Int, error) {
+	return _MultiSig.Contract.Confirmations(&_MultiSig.CallOpts, arg0)
+}
+
+// Confirmations is a free data retrieval call binding the contract method 0xec95bfe7.
+//
+// Solidity: function confirmations(bytes32 ) view returns(uint256)
+func (_MultiSig *MultiSigCallerSession) Confirmations(arg0 [32]byte) (*big.Int, error) {
+	return _MultiSig.Contract.Confirmations(&_MultiSig.CallOpts, arg0)
+}
+
+// Confirmed is a free data retrieval call binding the contract method 0x742aad2e.
+//
+// Solidity: function confirmed(bytes32 , address ) view returns(bool)
+func (_MultiSig *MultiSigCaller) Confirmed(opts *bind.CallOpts, arg0 [32]byte, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _MultiSig.contract.Call(opts, &out, "confirmed", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Confirmed is a free data retrieval call binding the contract method 0x742aad2e.
+//
+// Solidity: function confirmed(bytes32 , address ) view returns(bool)
+func (_MultiSig *MultiSigSession) Confirmed(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _MultiSig.Contract.Confirmed(&_MultiSig.CallOpts, arg0, arg1)
+}
+
+// Confirmed is a free data retrieval call binding the contract method 0x742aad2e.
+//
+// Solidity: function confirmed(bytes32 , address ) view returns(bool)
+func (_MultiSig *MultiSigCallerSession) Confirmed(arg0 [32]byte, arg1 common.Address) (bool, error) {
+	return _MultiSig.Contract.Confirmed(&_MultiSig.CallOpts, arg0, arg1)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address ) view returns(bool)
+func (_MultiSig *MultiSigCaller) IsOwner(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _MultiSig.contract.Call(opts, &out, "isOwner", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address ) view returns(bool)
+func (_MultiSig *MultiSigSession) IsOwner(arg0 common.Address) (bool, error) {
+	return _MultiSig.Contract.IsOwner(&_MultiSig.CallOpts, arg0)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address ) view returns(bool)
+func (_MultiSig *MultiSigCallerSession) IsOwner(arg0 common.Address) (bool, error) {
+	return _MultiSig.Contract.IsOwner(&_MultiSig.CallOpts, arg0)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_MultiSig *MultiSigCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MultiSig.contract.Call(opts, &out, "nonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_MultiSig *MultiSigSession) Nonce() (*big.Int, error) {
+	return _MultiSig.Contract.Nonce(&_MultiSig.CallOpts)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_MultiSig *MultiSigCallerSession) Nonce() (*big.Int, error) {
+	return _MultiSig.Contract.Nonce(&_MultiSig.CallOpts)
+}
+
+// Threshold is a free data retrieval call binding the contract method 0x42cde4e8.
+//
+// Solidity: function threshold() view returns(uint256)
+func (_MultiSig *MultiSigCaller) Threshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MultiSig.contract.Call(opts, &out, "threshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Threshold is a free data retrieval call binding the contract method 0x42cde4e8.
+//
+// Solidity: function threshold() view returns(uint256)
+func (_MultiSig *MultiSigSession) Threshold() (*big.Int, error) {
+	return _MultiSig.Contract.Threshold(&_MultiSig.CallOpts)
+}
+
+// Threshold is a free data retrieval call binding the contract method 0x42cde4e8.
+//
+// Solidity: function threshold() view returns(uint256)
+func (_MultiSig *MultiSigCallerSession) Threshold() (*big.Int, error) {
+	return _MultiSig.Contract.Threshold(&_MultiSig.CallOpts)
+}
+
+// Confirm is a paid mutator transaction binding the contract method 0x797af627.
+//
+// Solidity: function confirm(bytes32 hash) returns()
+func (_MultiSig *MultiSigTransactor) Confirm(opts *bind.TransactOpts, hash [32]byte) (*types.Transaction, error) {
+	return _MultiSig.contract.Transact(opts, "confirm", hash)
+}
+
+// Confirm is a paid mutator transaction binding the contract method 0x797af627.
+//
+// Solidity: function confirm(bytes32 hash) returns()
+func (_MultiSig *MultiSigSession) Confirm(hash [32]byte) (*types.Transaction, error) {
+	return _MultiSig.Contract.Confirm(&_MultiSig.TransactOpts, hash)
+}
+
+// Confirm is a paid mutator transaction binding the contract method 0x797af627.
+//
+// Solidity: function confirm(bytes32 hash) returns()
+func (_MultiSig *MultiSigTransactorSession) Confirm(hash [32]byte) (*types.Transaction, error) {
+	return _MultiSig.Contract.Confirm(&_MultiSig.TransactOpts, hash)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb61d27f6.
+//
+// Solidity: function execute(address to, uint256 value, bytes data) returns()
+func (_MultiSig *MultiSigTransactor) Execute(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _MultiSig.contract.Transact(opts, "execute", to, value, data)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb61d27f6.
+//
+// Solidity: function execute(address to, uint256 value, bytes data) returns()
+func (_MultiSig *MultiSigSession) Execute(to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _MultiSig.Contract.Execute(&_MultiSig.TransactOpts, to, value, data)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xb61d27f6.
+//
+// Solidity: function execute(address to, uint256 value, bytes data) returns()
+func (_MultiSig *MultiSigTransactorSession) Execute(to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _MultiSig.Contract.Execute(&_MultiSig.TransactOpts, to, value, data)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MultiSig *MultiSigTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MultiSig.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MultiSig *MultiSigSession) Receive() (*types.Transaction, error) {
+	return _MultiSig.Contract.Receive(&_MultiSig.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MultiSig *MultiSigTransactorSession) Receive() (*types.Transaction, error) {
+	return _MultiSig.Contract.Receive(&_MultiSig.TransactOpts)
+}
+
+// MultiSigConfirmationIterator is returned from FilterConfirmation and is used to iterate over the raw logs and unpacked data for Confirmation events raised by the MultiSig contract.
+type MultiSigConfirmationIterator struct {
+	Event *MultiSigConfirmation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log      // Log channel receiving the found contract events
+	sub  klaytn.Subscription // Subscription for errors, completion and termination
+	done bool                // Whether the subscription completed delivering logs
+	fail error               // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigConfirmationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigConfirmation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigConfirmation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigConfirmationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigConfirmationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigConfirmation represents a Confirmation event raised by the MultiSig contract.
+type MultiSigConfirmation struct {
+	Owner common.Address
+	Hash  [32]byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterConfirmation is a free log retrieval operation binding the contract event 0xe1c52dc63b719ade82e8bea94cc41a0d5d28e4aaf536adb5e9cccc9ff8c1aeda.
+//
+// Solidity: event Confirmation(address indexed owner, bytes32 indexed hash)
+func (_MultiSig *MultiSigFilterer) FilterConfirmation(opts *bind.FilterOpts, owner []common.Address, hash [][32]byte) (*MultiSigConfirmationIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var hashRule []interface{}
+	for _, hashItem := range hash {
+		hashRule = append(hashRule, hashItem)
+	}
+
+	logs, sub, err := _MultiSig.contract.FilterLogs(opts, "Confirmation", ownerRule, hashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigConfirmationIterator{contract: _MultiSig.contract, event: "Confirmation", logs: logs, sub: sub}, nil
+}
+
+// WatchConfirmation is a free log subscription operation binding the contract event 0xe1c52dc63b719ade82e8bea94cc41a0d5d28e4aaf536adb5e9cccc9ff8c1aeda.
+//
+// Solidity: event Confirmation(address indexed owner, bytes32 indexed hash)
+func (_MultiSig *MultiSigFilterer) WatchConfirmation(opts *bind.WatchOpts, sink chan<- *MultiSigConfirmation, owner []common.Address, hash [][32]byte) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var hashRule []interface{}
+	for _, hashItem := range hash {
+		hashRule = append(hashRule, hashItem)
+	}
+
+	logs, sub, err := _MultiSig.contract.WatchLogs(opts, "Confirmation", ownerRule, hashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigConfirmation)
+				if err := _MultiSig.contract.UnpackLog(event, "Confirmation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConfirmation is a log parse operation binding the contract event 0xe1c52dc63b719ade82e8bea94cc41a0d5d28e4aaf536adb5e9cccc9ff8c1aeda.
+//
+// Solidity: event Confirmation(address indexed owner, bytes32 indexed hash)
+func (_MultiSig *MultiSigFilterer) ParseConfirmation(log types.Log) (*MultiSigConfirmation, error) {
+	event := new(MultiSigConfirmation)
+	if err := _MultiSig.contract.UnpackLog(event, "Confirmation", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// MultiSigExecutionIterator is returned from FilterExecution and is used to iterate over the raw logs and unpacked data for Execution events raised by the MultiSig contract.
+type MultiSigExecutionIterator struct {
+	Event *MultiSigExecution // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log      // Log channel receiving the found contract events
+	sub  klaytn.Subscription // Subscription for errors, completion and termination
+	done bool                // Whether the subscription completed delivering logs
+	fail error               // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigExecutionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigExecution)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigExecution)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigExecutionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigExecutionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigExecution represents a Execution event raised by the MultiSig contract.
+type MultiSigExecution struct {
+	Hash [32]byte
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecution is a free log retrieval operation binding the contract event 0x7e9e1cb65db4927b1815f498cbaa226a15c277816f7df407573682110522c9b1.
+//
+// Solidity: event Execution(bytes32 indexed hash)
+func (_MultiSig *MultiSigFilterer) FilterExecution(opts *bind.FilterOpts, hash [][32]byte) (*MultiSigExecutionIterator, error) {
+
+	var hashRule []interface{}
+	for _, hashItem := range hash {
+		hashRule = append(hashRule, hashItem)
+	}
+
+	logs, sub, err := _MultiSig.contract.FilterLogs(opts, "Execution", hashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigExecutionIterator{contract: _MultiSig.contract, event: "Execution", logs: logs, sub: sub}, nil
+}
+
+// WatchExecution is a free log subscription operation binding the contract event 0x7e9e1cb65db4927b1815f498cbaa226a15c277816f7df407573682110522c9b1.
+//
+// Solidity: event Execution(bytes32 indexed hash)
+func (_MultiSig *MultiSigFilterer) WatchExecution(opts *bind.WatchOpts, sink chan<- *MultiSigExecution, hash [][32]byte) (event.Subscription, error) {
+
+	var hashRule []interface{}
+	for _, hashItem := range hash {
+		hashRule = append(hashRule, hashItem)
+	}
+
+	logs, sub, err := _MultiSig.contract.WatchLogs(opts, "Execution", hashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigExecution)
+				if err := _MultiSig.contract.UnpackLog(event, "Execution", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecution is a log parse operation binding the contract event 0x7e9e1cb65db4927b1815f498cbaa226a15c277816f7df407573682110522c9b1.
+//
+// Solidity: event Execution(bytes32 indexed hash)
+func (_MultiSig *MultiSigFilterer) ParseExecution(log types.Log) (*MultiSigExecution, error) {
+	event := new(MultiSigExecution)
+	if err := _MultiSig.contract.UnpackLog(event, "Execution", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
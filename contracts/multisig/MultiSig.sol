@@ -0,0 +1,84 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.0;
+
+/**
+ * @dev MultiSig executes a call once as many owners as the threshold have confirmed it.
+ * A call is identified by callHash, which includes the nonce so that an executed call cannot be replayed.
+ * Note: homi installs it at the genesis block with its owners and threshold in the storage, in which case
+ * the constructor is not executed.
+ */
+contract MultiSig {
+    uint256 public threshold;
+    uint256 public nonce;
+    mapping(address => bool) public isOwner;
+    mapping(bytes32 => uint256) public confirmations;
+    mapping(bytes32 => mapping(address => bool)) public confirmed;
+
+    event Confirmation(address indexed owner, bytes32 indexed hash);
+    event Execution(bytes32 indexed hash);
+
+    constructor(address[] memory _owners, uint256 _threshold) {
+        require(_threshold > 0 && _threshold <= _owners.length, "Invalid threshold");
+        for (uint256 i = 0; i < _owners.length; i++) {
+            require(_owners[i] != address(0) && !isOwner[_owners[i]], "Invalid owner");
+            isOwner[_owners[i]] = true;
+        }
+        threshold = _threshold;
+    }
+
+    receive() external payable {}
+
+    /**
+     * @dev Confirms the call of the hash by the sender.
+     */
+    function confirm(bytes32 hash) external {
+        require(isOwner[msg.sender], "Not owner");
+        require(!confirmed[hash][msg.sender], "Already confirmed");
+        confirmed[hash][msg.sender] = true;
+        confirmations[hash]++;
+        emit Confirmation(msg.sender, hash);
+    }
+
+    /**
+     * @dev Executes the confirmed call and increases the nonce.
+     */
+    function execute(
+        address to,
+        uint256 value,
+        bytes calldata data
+    ) external {
+        bytes32 hash = callHash(to, value, data);
+        require(confirmations[hash] >= threshold, "Not confirmed");
+        nonce++;
+        (bool success, ) = to.call{value: value}(data);
+        require(success, "Call failed");
+        emit Execution(hash);
+    }
+
+    /**
+     * @dev Returns the hash of the call to be confirmed at the current nonce.
+     */
+    function callHash(
+        address to,
+        uint256 value,
+        bytes calldata data
+    ) public view returns (bytes32) {
+        return keccak256(abi.encode(nonce, to, value, data));
+    }
+}
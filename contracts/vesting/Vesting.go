@@ -0,0 +1,623 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package vesting
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = klaytn.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VestingMetaData contains all meta data concerning the Vesting contract.
+var VestingMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_beneficiary\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_cliff\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_duration\",\"type\":\"uint256\"}],\"stateMutability\":\"payable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Released\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"amount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"beneficiary\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"cliff\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"duration\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"releasable\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"release\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"released\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"start\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"vested\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"aa8c217c": "amount()",
+		"38af3eed": "beneficiary()",
+		"13d033c0": "cliff()",
+		"0fb5a6b4": "duration()",
+		"fbccedae": "releasable()",
+		"86d1a69f": "release()",
+		"96132521": "released()",
+		"be9a6555": "start()",
+		"fea5657c": "vested()",
+	},
+	Bin: "0x608060405260405161043c38038061043c833981016040819052610022916100aa565b808211156100765760405162461bcd60e51b815260206004820152601660248201527f436c6966662065786365656473206475726174696f6e00000000000000000000604482015260640160405180910390fd5b600080546001600160a01b0319166001600160a01b03949094169390931790925542600155600255600355346004556100ed565b6000806000606084860312156100bf57600080fd5b83516001600160a01b03811681146100d657600080fd5b602085015160409095015190969495509392505050565b610340806100fc6000396000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c8063961325211161006657806396132521146100f2578063aa8c217c146100fb578063be9a655514610104578063fbccedae1461010d578063fea5657c1461011557600080fd5b80630fb5a6b41461009857806313d033c0146100b457806338af3eed146100bd57806386d1a69f146100e8575b600080fd5b6100a160035481565b6040519081526020015b60405180910390f35b6100a160025481565b6000546100d0906001600160a01b031681565b6040516001600160a01b0390911681526020016100ab565b6100f061011d565b005b6100a160055481565b6100a160045481565b6100a160015481565b6100a1610210565b6100a161022c565b6000610127610210565b9050806005600082825461013b91906102a5565b9091555050600080546040516001600160a01b039091169083908381818185875af1925050503d806000811461018d576040519150601f19603f3d011682016040523d82523d6000602084013e610192565b606091505b50509050806101d95760405162461bcd60e51b815260206004820152600f60248201526e151c985b9cd9995c8819985a5b1959608a1b604482015260640160405180910390fd5b6040518281527ffb81f9b30d73d830c3544b34d827c08142579ee75710b490bab0b3995468c5659060200160405180910390a15050565b600060055461021d61022c565b61022791906102be565b905090565b600060025460015461023e91906102a5565b42101561024b5750600090565b60035460015461025b91906102a5565b4210610268575060045490565b60035460015461027890426102be565b60045461028591906102d1565b61022791906102e8565b634e487b7160e01b600052601160045260246000fd5b808201808211156102b8576102b861028f565b92915050565b818103818111156102b8576102b861028f565b80820281158282048414176102b8576102b861028f565b60008261030557634e487b7160e01b600052601260045260246000fd5b50049056fea264697066735822122079750a86081fd358b302176d51f5ffa53cccb52658b31bde7d2da49bf703a45964736f6c63430008150033",
+}
+
+// VestingABI is the input ABI used to generate the binding from.
+// Deprecated: Use VestingMetaData.ABI instead.
+var VestingABI = VestingMetaData.ABI
+
+// VestingBinRuntime is the compiled bytecode used for adding genesis block without deploying code.
+const VestingBinRuntime = `608060405234801561001057600080fd5b50600436106100935760003560e01c8063961325211161006657806396132521146100f2578063aa8c217c146100fb578063be9a655514610104578063fbccedae1461010d578063fea5657c1461011557600080fd5b80630fb5a6b41461009857806313d033c0146100b457806338af3eed146100bd57806386d1a69f146100e8575b600080fd5b6100a160035481565b6040519081526020015b60405180910390f35b6100a160025481565b6000546100d0906001600160a01b031681565b6040516001600160a01b0390911681526020016100ab565b6100f061011d565b005b6100a160055481565b6100a160045481565b6100a160015481565b6100a1610210565b6100a161022c565b6000610127610210565b9050806005600082825461013b91906102a5565b9091555050600080546040516001600160a01b039091169083908381818185875af1925050503d806000811461018d576040519150601f19603f3d011682016040523d82523d6000602084013e610192565b606091505b50509050806101d95760405162461bcd60e51b815260206004820152600f60248201526e151c985b9cd9995c8819985a5b1959608a1b604482015260640160405180910390fd5b6040518281527ffb81f9b30d73d830c3544b34d827c08142579ee75710b490bab0b3995468c5659060200160405180910390a15050565b600060055461021d61022c565b61022791906102be565b905090565b600060025460015461023e91906102a5565b42101561024b5750600090565b60035460015461025b91906102a5565b4210610268575060045490565b60035460015461027890426102be565b60045461028591906102d1565b61022791906102e8565b634e487b7160e01b600052601160045260246000fd5b808201808211156102b8576102b861028f565b92915050565b818103818111156102b8576102b861028f565b80820281158282048414176102b8576102b861028f565b60008261030557634e487b7160e01b600052601260045260246000fd5b50049056fea264697066735822122079750a86081fd358b302176d51f5ffa53cccb52658b31bde7d2da49bf703a45964736f6c63430008150033`
+
+// VestingFuncSigs maps the 4-byte function signature to its string representation.
+// Deprecated: Use VestingMetaData.Sigs instead.
+var VestingFuncSigs = VestingMetaData.Sigs
+
+// VestingBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use VestingMetaData.Bin instead.
+var VestingBin = VestingMetaData.Bin
+
+// DeployVesting deploys a new Klaytn contract, binding an instance of Vesting to it.
+func DeployVesting(auth *bind.TransactOpts, backend bind.ContractBackend, _beneficiary common.Address, _cliff *big.Int, _duration *big.Int) (common.Address, *types.Transaction, *Vesting, error) {
+	parsed, err := VestingMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(VestingBin), backend, _beneficiary, _cliff, _duration)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Vesting{VestingCaller: VestingCaller{contract: contract}, VestingTransactor: VestingTransactor{contract: contract}, VestingFilterer: VestingFilterer{contract: contract}}, nil
+}
+
+// Vesting is an auto generated Go binding around a Klaytn contract.
+type Vesting struct {
+	VestingCaller     // Read-only binding to the contract
+	VestingTransactor // Write-only binding to the contract
+	VestingFilterer   // Log filterer for contract events
+}
+
+// VestingCaller is an auto generated read-only Go binding around a Klaytn contract.
+type VestingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VestingTransactor is an auto generated write-only Go binding around a Klaytn contract.
+type VestingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VestingFilterer is an auto generated log filtering Go binding around a Klaytn contract events.
+type VestingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VestingSession is an auto generated Go binding around a Klaytn contract,
+// with pre-set call and transact options.
+type VestingSession struct {
+	Contract     *Vesting          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VestingCallerSession is an auto generated read-only Go binding around a Klaytn contract,
+// with pre-set call options.
+type VestingCallerSession struct {
+	Contract *VestingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// VestingTransactorSession is an auto generated write-only Go binding around a Klaytn contract,
+// with pre-set transact options.
+type VestingTransactorSession struct {
+	Contract     *VestingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// VestingRaw is an auto generated low-level Go binding around a Klaytn contract.
+type VestingRaw struct {
+	Contract *Vesting // Generic contract binding to access the raw methods on
+}
+
+// VestingCallerRaw is an auto generated low-level read-only Go binding around a Klaytn contract.
+type VestingCallerRaw struct {
+	Contract *VestingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VestingTransactorRaw is an auto generated low-level write-only Go binding around a Klaytn contract.
+type VestingTransactorRaw struct {
+	Contract *VestingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVesting creates a new instance of Vesting, bound to a specific deployed contract.
+func NewVesting(address common.Address, backend bind.ContractBackend) (*Vesting, error) {
+	contract, err := bindVesting(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Vesting{VestingCaller: VestingCaller{contract: contract}, VestingTransactor: VestingTransactor{contract: contract}, VestingFilterer: VestingFilterer{contract: contract}}, nil
+}
+
+// NewVestingCaller creates a new read-only instance of Vesting, bound to a specific deployed contract.
+func NewVestingCaller(address common.Address, caller bind.ContractCaller) (*VestingCaller, error) {
+	contract, err := bindVesting(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VestingCaller{contract: contract}, nil
+}
+
+// NewVestingTransactor creates a new write-only instance of Vesting, bound to a specific deployed contract.
+func NewVestingTransactor(address common.Address, transactor bind.ContractTransactor) (*VestingTransactor, error) {
+	contract, err := bindVesting(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VestingTransactor{contract: contract}, nil
+}
+
+// NewVestingFilterer creates a new log filterer instance of Vesting, bound to a specific deployed contract.
+func NewVestingFilterer(address common.Address, filterer bind.ContractFilterer) (*VestingFilterer, error) {
+	contract, err := bindVesting(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VestingFilterer{contract: contract}, nil
+}
+
+// bindVesting binds a generic wrapper to an already deployed contract.
+func bindVesting(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VestingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vesting *VestingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Vesting.Contract.VestingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vesting *VestingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vesting.Contract.VestingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vesting *VestingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vesting.Contract.VestingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vesting *VestingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Vesting.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vesting *VestingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vesting.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vesting *VestingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vesting.Contract.contract.Transact(opts, method, params...)
+}
+
+// Amount is a free data retrieval call binding the contract method 0xaa8c217c.
+//
+// Solidity: function amount() view returns(uint256)
+func (_Vesting *VestingCaller) Amount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "amount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Amount is a free data retrieval call binding the contract method 0xaa8c217c.
+//
+// Solidity: function amount() view returns(uint256)
+func (_Vesting *VestingSession) Amount() (*big.Int, error) {
+	return _Vesting.Contract.Amount(&_Vesting.CallOpts)
+}
+
+// Amount is a free data retrieval call binding the contract method 0xaa8c217c.
+//
+// Solidity: function amount() view returns(uint256)
+func (_Vesting *VestingCallerSession) Amount() (*big.Int, error) {
+	return _Vesting.Contract.Amount(&_Vesting.CallOpts)
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_Vesting *VestingCaller) Beneficiary(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "beneficiary")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_Vesting *VestingSession) Beneficiary() (common.Address, error) {
+	return _Vesting.Contract.Beneficiary(&_Vesting.CallOpts)
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_Vesting *VestingCallerSession) Beneficiary() (common.Address, error) {
+	return _Vesting.Contract.Beneficiary(&_Vesting.CallOpts)
+}
+
+// Cliff is a free data retrieval call binding the contract method 0x13d033c0.
+//
+// Solidity: function cliff() view returns(uint256)
+func (_Vesting *VestingCaller) Cliff(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "cliff")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Cliff is a free data retrieval call binding the contract method 0x13d033c0.
+//
+// Solidity: function cliff() view returns(uint256)
+func (_Vesting *VestingSession) Cliff() (*big.Int, error) {
+	return _Vesting.Contract.Cliff(&_Vesting.CallOpts)
+}
+
+// Cliff is a free data retrieval call binding the contract method 0x13d033c0.
+//
+// Solidity: function cliff() view returns(uint256)
+func (_Vesting *VestingCallerSession) Cliff() (*big.Int, error) {
+	return _Vesting.Contract.Cliff(&_Vesting.CallOpts)
+}
+
+// Duration is a free data retrieval call binding the contract method 0x0fb5a6b4.
+//
+// Solidity: function duration() view returns(uint256)
+func (_Vesting *VestingCaller) Duration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "duration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Duration is a free data retrieval call binding the contract method 0x0fb5a6b4.
+//
+// Solidity: function duration() view returns(uint256)
+func (_Vesting *VestingSession) Duration() (*big.Int, error) {
+	return _Vesting.Contract.Duration(&_Vesting.CallOpts)
+}
+
+// Duration is a free data retrieval call binding the contract method 0x0fb5a6b4.
+//
+// Solidity: function duration() view returns(uint256)
+func (_Vesting *VestingCallerSession) Duration() (*big.Int, error) {
+	return _Vesting.Contract.Duration(&_Vesting.CallOpts)
+}
+
+// Releasable is a free data retrieval call binding the contract method 0xfbccedae.
+//
+// Solidity: function releasable() view returns(uint256)
+func (_Vesting *VestingCaller) Releasable(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "releasable")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Releasable is a free data retrieval call binding the contract method 0xfbccedae.
+//
+// Solidity: function releasable() view returns(uint256)
+func (_Vesting *VestingSession) Releasable() (*big.Int, error) {
+	return _Vesting.Contract.Releasable(&_Vesting.CallOpts)
+}
+
+// Releasable is a free data retrieval call binding the contract method 0xfbccedae.
+//
+// Solidity: function releasable() view returns(uint256)
+func (_Vesting *VestingCallerSession) Releasable() (*big.Int, error) {
+	return _Vesting.Contract.Releasable(&_Vesting.CallOpts)
+}
+
+// Released is a free data retrieval call binding the contract method 0x96132521.
+//
+// Solidity: function released() view returns(uint256)
+func (_Vesting *VestingCaller) Released(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "released")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Released is a free data retrieval call binding the contract method 0x96132521.
+//
+// Solidity: function released() view returns(uint256)
+func (_Vesting *VestingSession) Released() (*big.Int, error) {
+	return _Vesting.Contract.Released(&_Vesting.CallOpts)
+}
+
+// Released is a free data retrieval call binding the contract method 0x96132521.
+//
+// Solidity: function released() view returns(uint256)
+func (_Vesting *VestingCallerSession) Released() (*big.Int, error) {
+	return _Vesting.Contract.Released(&_Vesting.CallOpts)
+}
+
+// Start is a free data retrieval call binding the contract method 0xbe9a6555.
+//
+// Solidity: function start() view returns(uint256)
+func (_Vesting *VestingCaller) Start(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "start")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Start is a free data retrieval call binding the contract method 0xbe9a6555.
+//
+// Solidity: function start() view returns(uint256)
+func (_Vesting *VestingSession) Start() (*big.Int, error) {
+	return _Vesting.Contract.Start(&_Vesting.CallOpts)
+}
+
+// Start is a free data retrieval call binding the contract method 0xbe9a6555.
+//
+// Solidity: function start() view returns(uint256)
+func (_Vesting *VestingCallerSession) Start() (*big.Int, error) {
+	return _Vesting.Contract.Start(&_Vesting.CallOpts)
+}
+
+// Vested is a free data retrieval call binding the contract method 0xfea5657c.
+//
+// Solidity: function vested() view returns(uint256)
+func (_Vesting *VestingCaller) Vested(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Vesting.contract.Call(opts, &out, "vested")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Vested is a free data retrieval call binding the contract method 0xfea5657c.
+//
+// Solidity: function vested() view returns(uint256)
+func (_Vesting *VestingSession) Vested() (*big.Int, error) {
+	return _Vesting.Contract.Vested(&_Vesting.CallOpts)
+}
+
+// Vested is a free data retrieval call binding the contract method 0xfea5657c.
+//
+// Solidity: function vested() view returns(uint256)
+func (_Vesting *VestingCallerSession) Vested() (*big.Int, error) {
+	return _Vesting.Contract.Vested(&_Vesting.CallOpts)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x86d1a69f.
+//
+// Solidity: function release() returns()
+func (_Vesting *VestingTransactor) Release(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vesting.contract.Transact(opts, "release")
+}
+
+// Release is a paid mutator transaction binding the contract method 0x86d1a69f.
+//
+// Solidity: function release() returns()
+func (_Vesting *VestingSession) Release() (*types.Transaction, error) {
+	return _Vesting.Contract.Release(&_Vesting.TransactOpts)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x86d1a69f.
+//
+// Solidity: function release() returns()
+func (_Vesting *VestingTransactorSession) Release() (*types.Transaction, error) {
+	return _Vesting.Contract.Release(&_Vesting.TransactOpts)
+}
+
+// VestingReleasedIterator is returned from FilterReleased and is used to iterate over the raw logs and unpacked data for Released events raised by the Vesting contract.
+type VestingReleasedIterator struct {
+	Event *VestingReleased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log      // Log channel receiving the found contract events
+	sub  klaytn.Subscription // Subscription for errors, completion and termination
+	done bool                // Whether the subscription completed delivering logs
+	fail error               // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VestingReleasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VestingReleased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VestingReleased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VestingReleasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VestingReleasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VestingReleased represents a Released event raised by the Vesting contract.
+type VestingReleased struct {
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterReleased is a free log retrieval operation binding the contract event 0xfb81f9b30d73d830c3544b34d827c08142579ee75710b490bab0b3995468c565.
+//
+// Solidity: event Released(uint256 amount)
+func (_Vesting *VestingFilterer) FilterReleased(opts *bind.FilterOpts) (*VestingReleasedIterator, error) {
+
+	logs, sub, err := _Vesting.contract.FilterLogs(opts, "Released")
+	if err != nil {
+		return nil, err
+	}
+	return &VestingReleasedIterator{contract: _Vesting.contract, event: "Released", logs: logs, sub: sub}, nil
+}
+
+// WatchReleased is a free log subscription operation binding the contract event 0xfb81f9b30d73d830c3544b34d827c08142579ee75710b490bab0b3995468c565.
+//
+// Solidity: event Released(uint256 amount)
+func (_Vesting *VestingFilterer) WatchReleased(opts *bind.WatchOpts, sink chan<- *VestingReleased) (event.Subscription, error) {
+
+	logs, sub, err := _Vesting.contract.WatchLogs(opts, "Released")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VestingReleased)
+				if err := _Vesting.contract.UnpackLog(event, "Released", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReleased is a log parse operation binding the contract event 0xfb81f9b30d73d830c3544b34d827c08142579ee75710b490bab0b3995468c565.
+//
+// Solidity: event Released(uint256 amount)
+func (_Vesting *VestingFilterer) ParseReleased(log types.Log) (*VestingReleased, error) {
+	event := new(VestingReleased)
+	if err := _Vesting.contract.UnpackLog(event, "Released", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
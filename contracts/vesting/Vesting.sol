@@ -0,0 +1,79 @@
+// Copyright 2023 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.0;
+
+/**
+ * @dev Vesting releases the KLAY it holds to the beneficiary.
+ * The amount vests linearly from the start over the duration, and nothing is releasable before the cliff.
+ * Note: homi installs it at the genesis block with its parameters in the storage, in which case
+ * the constructor is not executed.
+ */
+contract Vesting {
+    address public beneficiary;
+    uint256 public start;
+    uint256 public cliff;
+    uint256 public duration;
+    uint256 public amount;
+    uint256 public released;
+
+    event Released(uint256 amount);
+
+    constructor(
+        address _beneficiary,
+        uint256 _cliff,
+        uint256 _duration
+    ) payable {
+        require(_cliff <= _duration, "Cliff exceeds duration");
+        beneficiary = _beneficiary;
+        start = block.timestamp;
+        cliff = _cliff;
+        duration = _duration;
+        amount = msg.value;
+    }
+
+    /**
+     * @dev Transfers the releasable amount to the beneficiary.
+     */
+    function release() external {
+        uint256 value = releasable();
+        released += value;
+        (bool success, ) = beneficiary.call{value: value}("");
+        require(success, "Transfer failed");
+        emit Released(value);
+    }
+
+    /**
+     * @dev Returns the vested amount which has not been released yet.
+     */
+    function releasable() public view returns (uint256) {
+        return vested() - released;
+    }
+
+    /**
+     * @dev Returns the amount vested so far.
+     */
+    function vested() public view returns (uint256) {
+        if (block.timestamp < start + cliff) {
+            return 0;
+        } else if (block.timestamp >= start + duration) {
+            return amount;
+        } else {
+            return (amount * (block.timestamp - start)) / duration;
+        }
+    }
+}
@@ -824,6 +824,12 @@ func (s *Stream) BigInt() (*big.Int, error) {
 	return i, nil
 }
 
+// ReadBigInt decodes an arbitrary-size integer value into dst.
+// Unlike BigInt, it reuses dst instead of allocating a new integer.
+func (s *Stream) ReadBigInt(dst *big.Int) error {
+	return s.decodeBigInt(dst)
+}
+
 func (s *Stream) decodeBigInt(dst *big.Int) error {
 	var buffer []byte
 	kind, size, err := s.Kind()
@@ -331,6 +331,35 @@ func TestStreamReadBytes(t *testing.T) {
 	}
 }
 
+func TestStreamReadBigInt(t *testing.T) {
+	tests := []struct {
+		input string
+		value *big.Int
+		err   error
+	}{
+		{input: "80", value: big.NewInt(0)},
+		{input: "04", value: big.NewInt(4)},
+		{input: "820102", value: big.NewInt(0x0102)},
+		{input: "89FFFFFFFFFFFFFFFFFF", value: veryBigInt},
+		{input: "820004", err: ErrCanonInt},
+		{input: "8104", err: ErrCanonSize},
+		{input: "C0", err: ErrExpectedString},
+	}
+
+	dst := big.NewInt(12345)
+	for _, test := range tests {
+		s := NewStream(bytes.NewReader(unhex(test.input)), 0)
+		err := s.ReadBigInt(dst)
+		if err != test.err {
+			t.Errorf("input %s: error mismatch, got %v, want %v", test.input, err, test.err)
+			continue
+		}
+		if test.err == nil && dst.Cmp(test.value) != 0 {
+			t.Errorf("input %s: value mismatch, got %v, want %v", test.input, dst, test.value)
+		}
+	}
+}
+
 func TestDecodeErrors(t *testing.T) {
 	r := bytes.NewReader(nil)
 